@@ -0,0 +1,285 @@
+package amt
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// VersionRange of affected firmware: Introduced <= version < Fixed.
+// Versions are dotted numbers, e.g. 11.6.27.3264 (version and build).
+type VersionRange struct {
+	Introduced string `json:"introduced"`
+	Fixed      string `json:"fixed"`
+}
+
+// Advisory is a security advisory affecting AMT firmware versions.
+type Advisory struct {
+	ID       string         `json:"id"`
+	Title    string         `json:"title"`
+	URL      string         `json:"url"`
+	Severity string         `json:"severity"`
+	Affected []VersionRange `json:"affected"`
+}
+
+// Advisories are matched against AMT firmware versions. Defaults may be
+// extended or overridden using LoadAdvisories.
+var Advisories = []Advisory{
+	{
+		ID:       "INTEL-SA-00075",
+		Title:    "Intel AMT, ISM and SBT escalation of privilege (unauthenticated digest login)",
+		URL:      "https://www.intel.com/content/www/us/en/security-center/advisory/intel-sa-00075.html",
+		Severity: "critical",
+		Affected: []VersionRange{
+			{"6.0", "6.2.61.3535"},
+			{"7.0", "7.1.91.3272"},
+			{"8.0", "8.1.71.3608"},
+			{"9.0", "9.1.41.3024"},
+			{"9.5", "9.5.61.3012"},
+			{"10.0", "10.0.55.3000"},
+			{"11.0", "11.0.25.3001"},
+			{"11.5", "11.6.27.3264"},
+		},
+	},
+	{
+		ID:       "INTEL-SA-00086",
+		Title:    "Intel ME, SPS and TXE firmware vulnerabilities (AMT buffer overflow)",
+		URL:      "https://www.intel.com/content/www/us/en/security-center/advisory/intel-sa-00086.html",
+		Severity: "high",
+		Affected: []VersionRange{
+			{"8.0", "8.1.72.3002"},
+			{"9.0", "9.1.42.3002"},
+			{"9.5", "9.5.63.3002"},
+			{"10.0", "10.0.56.3002"},
+			{"11.0", "11.8.50.3425"},
+		},
+	},
+	{
+		// no fixes for firmware before 11.x, which is affected back to 3.x
+		ID:       "INTEL-SA-00112",
+		Title:    "Intel ME and AMT firmware vulnerabilities (AMT remote code execution)",
+		URL:      "https://www.intel.com/content/www/us/en/security-center/advisory/intel-sa-00112.html",
+		Severity: "high",
+		Affected: []VersionRange{
+			{"3.0", "11.8.55"},
+			{"11.10", "11.11.55"},
+			{"11.20", "11.21.55"},
+		},
+	},
+	{
+		ID:       "INTEL-SA-00141",
+		Title:    "Intel CSME escalation of privilege and information disclosure",
+		URL:      "https://www.intel.com/content/www/us/en/security-center/advisory/intel-sa-00141.html",
+		Severity: "high",
+		Affected: []VersionRange{
+			{"11.0", "11.8.60"},
+			{"11.10", "11.11.60"},
+			{"11.20", "11.22.60"},
+			{"12.0", "12.0.20"},
+		},
+	},
+	{
+		ID:       "INTEL-SA-00213",
+		Title:    "Intel CSME, SPS, TXE, DAL and AMT vulnerabilities",
+		URL:      "https://www.intel.com/content/www/us/en/security-center/advisory/intel-sa-00213.html",
+		Severity: "high",
+		Affected: []VersionRange{
+			{"11.0", "11.8.65"},
+			{"11.10", "11.11.65"},
+			{"11.20", "11.22.65"},
+			{"12.0", "12.0.35"},
+		},
+	},
+	{
+		ID:       "INTEL-SA-00241",
+		Title:    "Intel CSME, SPS, TXE, AMT and DAL vulnerabilities",
+		URL:      "https://www.intel.com/content/www/us/en/security-center/advisory/intel-sa-00241.html",
+		Severity: "high",
+		Affected: []VersionRange{
+			{"11.0", "11.8.70"},
+			{"11.10", "11.11.70"},
+			{"11.20", "11.22.70"},
+			{"12.0", "12.0.45"},
+		},
+	},
+	{
+		ID:       "INTEL-SA-00295",
+		Title:    "Intel AMT and ISM unauthenticated escalation of privilege (IPv6)",
+		URL:      "https://www.intel.com/content/www/us/en/security-center/advisory/intel-sa-00295.html",
+		Severity: "critical",
+		Affected: []VersionRange{
+			{"11.0", "11.8.77"},
+			{"11.10", "11.12.77"},
+			{"11.20", "11.22.77"},
+			{"12.0", "12.0.64"},
+			{"13.0", "13.0.32"},
+			{"14.0", "14.0.33"},
+		},
+	},
+}
+
+// LoadAdvisories reads a JSON array of advisories from filename. Advisories
+// replace those having the same ID, others are added.
+func LoadAdvisories(filename string) error {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	var loaded []Advisory
+	if err = json.Unmarshal(data, &loaded); err != nil {
+		return fmt.Errorf("invalid advisory file %s: %s", filename, err)
+	}
+	for _, advisory := range loaded {
+		if advisory.ID == "" || len(advisory.Affected) == 0 {
+			return fmt.Errorf("invalid advisory file %s: advisory without ID or affected versions", filename)
+		}
+		replaced := false
+		for i := range Advisories {
+			if Advisories[i].ID == advisory.ID {
+				Advisories[i] = advisory
+				replaced = true
+			}
+		}
+		if !replaced {
+			Advisories = append(Advisories, advisory)
+		}
+	}
+	return nil
+}
+
+// Affects returns true if version is within an affected range.
+func (a Advisory) Affects(version string) bool {
+	if version == "" {
+		return false
+	}
+	for _, r := range a.Affected {
+		if compareVersions(version, r.Introduced) >= 0 && compareVersions(version, r.Fixed) < 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchAdvisories returns the advisories affecting an AMT firmware version.
+func MatchAdvisories(version string) (matches []Advisory) {
+	for _, advisory := range Advisories {
+		if advisory.Affects(version) {
+			matches = append(matches, advisory)
+		}
+	}
+	return
+}
+
+// AdvisoryIDs returns the IDs of advisories affecting an AMT firmware version.
+func AdvisoryIDs(version string) []string {
+	ids := []string{}
+	for _, advisory := range MatchAdvisories(version) {
+		ids = append(ids, advisory.ID)
+	}
+	return ids
+}
+
+// compareVersions compares dotted version numbers; missing components
+// count as 0. It returns -1, 0 or 1 like strings.Compare.
+func compareVersions(a string, b string) int {
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// FirmwareAudit lists advisories affecting the firmware of a host.
+type FirmwareAudit struct {
+	HostID     int      `json:"id"`
+	Hostname   string   `json:"hostname"`
+	AmtVersion string   `json:"amt_version"`
+	Advisories []string `json:"advisories"`
+}
+
+// FirmwareAudits are served by the REST API, with the advisories matched.
+type FirmwareAudits struct {
+	Advisories     []Advisory      `json:"advisories"`
+	FirmwareAudits []FirmwareAudit `json:"firmware_audits"`
+}
+
+// CliAuditFirmware queries the firmware of a list of hosts and reports
+// advisories affecting them.
+func CliAuditFirmware(hosts []string, format string, options Optionset) {
+	if len(hosts) == 0 {
+		fmt.Println("Error: Expected list of hostnames as arguments")
+		return
+	}
+	if format != FormatJSON && format != FormatTable {
+		fmt.Printf("Error: Unsupported output format %s\n", format)
+		return
+	}
+	options = cliOptions(options)
+
+	var audits []FirmwareAudit
+	for _, host := range hosts {
+		firmware, err := NewClient(host, options).Firmware()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: Error: %s\n", host, err)
+			continue
+		}
+		version := firmware.Version()
+		audits = append(audits, FirmwareAudit{Hostname: host, AmtVersion: version, Advisories: AdvisoryIDs(version)})
+	}
+
+	if format == FormatJSON {
+		data, _ := json.MarshalIndent(audits, "", "  ")
+		fmt.Println(string(data))
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Host\tAMT\tAdvisories")
+	for _, audit := range audits {
+		advisories := strings.Join(audit.Advisories, ",")
+		if advisories == "" {
+			advisories = "none"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", audit.Hostname, audit.AmtVersion, advisories)
+	}
+	w.Flush()
+}
+
+// CliAdvisories prints the advisories matched against firmware versions.
+func CliAdvisories(format string) {
+	if format != FormatJSON && format != FormatTable {
+		fmt.Printf("Error: Unsupported output format %s\n", format)
+		return
+	}
+	if format == FormatJSON {
+		data, _ := json.MarshalIndent(Advisories, "", "  ")
+		fmt.Println(string(data))
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Advisory\tSeverity\tAffected\tTitle")
+	for _, advisory := range Advisories {
+		var ranges []string
+		for _, r := range advisory.Affected {
+			ranges = append(ranges, r.Introduced+"-"+r.Fixed)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", advisory.ID, advisory.Severity, strings.Join(ranges, ","), advisory.Title)
+	}
+	w.Flush()
+}
@@ -0,0 +1,62 @@
+package amt
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestMatchAdvisories(t *testing.T) {
+	tests := map[string][]string{
+		"5.2.70.1000":   {"INTEL-SA-00112"},
+		"6.0.30.1205":   {"INTEL-SA-00075", "INTEL-SA-00112"},
+		"6.2.61.3535":   {"INTEL-SA-00112"},
+		"9.5.61.3012":   {"INTEL-SA-00086", "INTEL-SA-00112"},
+		"11.0.25":       {"INTEL-SA-00075", "INTEL-SA-00086", "INTEL-SA-00112", "INTEL-SA-00141", "INTEL-SA-00213", "INTEL-SA-00241", "INTEL-SA-00295"}, // build unknown
+		"11.8.50.3399":  {"INTEL-SA-00086", "INTEL-SA-00112", "INTEL-SA-00141", "INTEL-SA-00213", "INTEL-SA-00241", "INTEL-SA-00295"},
+		"11.8.77.3664":  {},
+		"11.11.65.3590": {"INTEL-SA-00241", "INTEL-SA-00295"},
+		"12.0.45.1509":  {"INTEL-SA-00295"},
+		"12.0.64.1551":  {},
+		"14.0.33.1074":  {},
+		"":              {},
+	}
+	for version, ids := range tests {
+		if got := AdvisoryIDs(version); !reflect.DeepEqual(got, ids) {
+			t.Errorf("Version %s: advisories %v, expected %v", version, got, ids)
+		}
+	}
+}
+
+func TestLoadAdvisories(t *testing.T) {
+	defaults := Advisories
+	defer func() { Advisories = defaults }()
+	Advisories = []Advisory{defaults[0]} // INTEL-SA-00075 only
+
+	file, err := ioutil.TempFile("", "advisories")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+	file.WriteString(`[{"id":"TEST-1","severity":"high","affected":[{"introduced":"11.0","fixed":"11.8.50"}]},
+		{"id":"INTEL-SA-00075","severity":"critical","affected":[{"introduced":"6.0","fixed":"6.2.61.3535"}]}]`)
+	file.Close()
+
+	if err = LoadAdvisories(file.Name()); err != nil {
+		t.Fatalf("LoadAdvisories failed: %s", err)
+	}
+	if len(Advisories) != 2 {
+		t.Fatalf("Expected 2 advisories, got %d", len(Advisories))
+	}
+	if ids := AdvisoryIDs("11.6.12.3202"); !reflect.DeepEqual(ids, []string{"TEST-1"}) {
+		t.Errorf("Unexpected advisories of 11.6.12.3202: %v", ids)
+	}
+	if ids := AdvisoryIDs("6.1.0"); !reflect.DeepEqual(ids, []string{"INTEL-SA-00075"}) {
+		t.Errorf("Unexpected advisories of 6.1.0: %v", ids)
+	}
+	ioutil.WriteFile(file.Name(), []byte(`[{"id":""}]`), 0600)
+	if err = LoadAdvisories(file.Name()); err == nil {
+		t.Error("Expected error loading advisory without ID")
+	}
+}
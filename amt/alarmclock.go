@@ -0,0 +1,256 @@
+package amt
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// AMT_AlarmClockService requires AMT 11.0+
+const alarmClockMinVersion = 11
+
+// namespace of CIM datetime and interval values
+const nsCIMCommon = "http://schemas.dmtf.org/wbem/wscim/1/common"
+
+// Alarm is an AMT alarm clock occurrence (IPS_AlarmClockOccurrence),
+// powering on the host at Start and, unless Interval is 0, every Interval.
+type Alarm struct {
+	Name               string        `json:"name"`
+	Start              time.Time     `json:"start"`
+	Interval           time.Duration `json:"interval"`
+	DeleteOnCompletion bool          `json:"delete_on_completion"`
+}
+
+// Validate checks name and start time of an alarm.
+func (a Alarm) Validate() error {
+	if a.Name == "" || len(a.Name) > 32 || strings.ContainsAny(a.Name, `<>&"`) {
+		return fmt.Errorf("invalid alarm name '%s'", a.Name)
+	}
+	if !a.Start.After(time.Now()) {
+		return fmt.Errorf("alarm start time %s is not in the future", a.Start.Format("2006-01-02 15:04"))
+	}
+	if a.Interval != 0 && a.Interval < time.Minute {
+		return fmt.Errorf("alarm interval %s is shorter than a minute", a.Interval)
+	}
+	return nil
+}
+
+type addAlarmInput struct {
+	XMLName  xml.Name `xml:"h:AddAlarm_INPUT"`
+	XmlnsH   string   `xml:"xmlns:h,attr"`
+	Template struct {
+		XmlnsQ             string `xml:"xmlns:q,attr"`
+		XmlnsCIM           string `xml:"xmlns:cim,attr"`
+		ElementName        string `xml:"q:ElementName"`
+		InstanceID         string `xml:"q:InstanceID"`
+		StartTime          string `xml:"q:StartTime>cim:Datetime"`
+		Interval           string `xml:"q:Interval>cim:Interval,omitempty"`
+		DeleteOnCompletion bool   `xml:"q:DeleteOnCompletion"`
+	} `xml:"h:AlarmTemplate"`
+}
+
+// Alarms returns the alarm clock occurrences of the host.
+func (c *Client) Alarms() ([]Alarm, error) {
+	data, err := c.enumerate(ipsAlarmClockOccurrence)
+	if err != nil {
+		return nil, err
+	}
+	var items struct {
+		Occurrences []struct {
+			ElementName        string `xml:"ElementName"`
+			InstanceID         string `xml:"InstanceID"`
+			StartTime          string `xml:"StartTime>Datetime"`
+			Interval           string `xml:"Interval>Interval"`
+			DeleteOnCompletion bool   `xml:"DeleteOnCompletion"`
+		} `xml:"IPS_AlarmClockOccurrence"`
+	}
+	if err = decodeItems(data, &items); err != nil {
+		return nil, err
+	}
+	var alarms []Alarm
+	for _, o := range items.Occurrences {
+		alarm := Alarm{Name: o.InstanceID, DeleteOnCompletion: o.DeleteOnCompletion}
+		if alarm.Name == "" {
+			alarm.Name = o.ElementName
+		}
+		if alarm.Start, err = time.Parse(time.RFC3339, strings.TrimSpace(o.StartTime)); err != nil {
+			return nil, fmt.Errorf("invalid start time of alarm %s: %s", alarm.Name, err)
+		}
+		if alarm.Interval, err = parseInterval(o.Interval); err != nil {
+			return nil, fmt.Errorf("invalid interval of alarm %s: %s", alarm.Name, err)
+		}
+		alarms = append(alarms, alarm)
+	}
+	return alarms, nil
+}
+
+// AddAlarm adds an alarm clock occurrence to the host.
+func (c *Client) AddAlarm(alarm Alarm) error {
+	if err := c.checkAlarmClock(); err != nil {
+		return err
+	}
+	input := addAlarmInput{XmlnsH: amtAlarmClockService}
+	input.Template.XmlnsQ = ipsAlarmClockOccurrence
+	input.Template.XmlnsCIM = nsCIMCommon
+	input.Template.ElementName = alarm.Name
+	input.Template.InstanceID = alarm.Name
+	input.Template.StartTime = alarm.Start.UTC().Format("2006-01-02T15:04:05Z")
+	input.Template.Interval = formatInterval(alarm.Interval)
+	input.Template.DeleteOnCompletion = alarm.DeleteOnCompletion
+
+	response, err := c.Send(Request{
+		Action:      amtAlarmClockService + "/AddAlarm",
+		ResourceURI: amtAlarmClockService,
+		Body:        input,
+	})
+	if err != nil {
+		return err
+	}
+	var output struct{ ReturnValue int }
+	if err = response.Decode(&output); err != nil {
+		return err
+	}
+	return checkReturnValue("AddAlarm", output.ReturnValue)
+}
+
+// DeleteAlarm deletes the alarm clock occurrence named name.
+func (c *Client) DeleteAlarm(name string) error {
+	return c.Delete(ipsAlarmClockOccurrence, []Selector{{"Name", name}})
+}
+
+// checkAlarmClock returns an error if the host's AMT version lacks the
+// alarm clock. Hosts of unknown version are assumed to support it.
+func (c *Client) checkAlarmClock() error {
+	capabilities, err := c.cachedPowerCapabilities()
+	if err != nil {
+		return nil
+	}
+	if major, err := amtMajorVersion(capabilities.AmtVersion); err == nil && major < alarmClockMinVersion {
+		return &ErrUnsupported{Feature: "alarm clock",
+			Detail: fmt.Sprintf("(AMT %s, requires %d.0+)", capabilities.AmtVersion, alarmClockMinVersion)}
+	}
+	return nil
+}
+
+// amtMajorVersion returns the major version of an AMT version string.
+func amtMajorVersion(version string) (int, error) {
+	return strconv.Atoi(strings.SplitN(version, ".", 2)[0])
+}
+
+var intervalRegexp = regexp.MustCompile(`^P(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// parseInterval parses a CIM interval (xs:duration without years and
+// months, e.g. P1DT12H). An empty interval is 0.
+func parseInterval(interval string) (time.Duration, error) {
+	interval = strings.TrimSpace(interval)
+	if interval == "" {
+		return 0, nil
+	}
+	m := intervalRegexp.FindStringSubmatch(interval)
+	if m == nil {
+		return 0, fmt.Errorf("unsupported interval %s", interval)
+	}
+	var d time.Duration
+	for i, unit := range []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second} {
+		if m[i+1] != "" {
+			n, _ := strconv.Atoi(m[i+1])
+			d += time.Duration(n) * unit
+		}
+	}
+	return d, nil
+}
+
+// formatInterval returns d as CIM interval, or "" if d is 0.
+func formatInterval(d time.Duration) string {
+	if d <= 0 {
+		return ""
+	}
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	hours := d / time.Hour
+	d -= hours * time.Hour
+	return fmt.Sprintf("P%dDT%dH%dM", days, hours, d/time.Minute)
+}
+
+// CliAlarms prints the alarm clock occurrences of a list of hosts.
+func CliAlarms(hosts []string, format string, options Optionset) {
+	if len(hosts) == 0 {
+		fmt.Println("Error: Expected list of hostnames as arguments")
+		return
+	}
+	if format != FormatJSON && format != FormatTable {
+		fmt.Printf("Error: Unsupported output format %s\n", format)
+		return
+	}
+	options = cliOptions(options)
+
+	results := make(map[string][]Alarm)
+	for _, host := range hosts {
+		alarms, err := NewClient(host, options).Alarms()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: Error: %s\n", host, err)
+			continue
+		}
+		results[host] = alarms
+	}
+
+	if format == FormatJSON {
+		data, _ := json.MarshalIndent(results, "", "  ")
+		fmt.Println(string(data))
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Host\tName\tStart\tInterval\tDelete on completion")
+	for _, host := range hosts {
+		for _, alarm := range results[host] {
+			interval := "once"
+			if alarm.Interval != 0 {
+				interval = alarm.Interval.String()
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\n", host, alarm.Name, alarm.Start.Local().Format("2006-01-02 15:04"),
+				interval, alarm.DeleteOnCompletion)
+		}
+	}
+	w.Flush()
+}
+
+// CliAlarmCommand adds or deletes (cmd CmdAlarmAdd or CmdAlarmDelete)
+// an alarm clock occurrence on a list of hosts.
+func CliAlarmCommand(cmd string, alarm Alarm, hosts []string, options Optionset) {
+	var err error
+	if cmd == CmdAlarmAdd {
+		err = alarm.Validate()
+	} else if alarm.Name == "" {
+		err = fmt.Errorf("no alarm name given")
+	}
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+	options.Alarm = alarm
+	CliCommand(cmd, hosts, options)
+}
+
+// ParseAlarmStart parses a CLI alarm start time given as local
+// "YYYY-MM-DD HH:MM", or as "HH:MM", meaning its next occurrence.
+func ParseAlarmStart(start string) (time.Time, error) {
+	if t, err := time.ParseInLocation("2006-01-02 15:04", start, time.Local); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation("15:04", start, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid start time '%s', expected HH:MM or YYYY-MM-DD HH:MM", start)
+	}
+	now := time.Now()
+	next := time.Date(now.Year(), now.Month(), now.Day(), t.Hour(), t.Minute(), 0, 0, time.Local)
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next, nil
+}
@@ -0,0 +1,82 @@
+package amt
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestInterval(t *testing.T) {
+	for interval, expected := range map[string]time.Duration{
+		"":            0,
+		"P1D":         24 * time.Hour,
+		"P7DT0H0M":    7 * 24 * time.Hour,
+		"PT1H30M":     90 * time.Minute,
+		"P0DT0H45M0S": 45 * time.Minute,
+	} {
+		if d, err := parseInterval(interval); err != nil || d != expected {
+			t.Errorf("parseInterval(%s) = %s, %v; expected %s", interval, d, err, expected)
+		}
+	}
+	if _, err := parseInterval("P1Y"); err == nil {
+		t.Errorf("Expected error parsing interval with years")
+	}
+	if formatInterval(36*time.Hour+5*time.Minute) != "P1DT12H5M" || formatInterval(0) != "" {
+		t.Errorf("Unexpected formatted interval %s", formatInterval(36*time.Hour+5*time.Minute))
+	}
+}
+
+func TestAlarms(t *testing.T) {
+	var added string
+	server, client := fakeAMT(t, func(action string, envelope string) (int, string) {
+		switch action {
+		case amtAlarmClockService + "/AddAlarm":
+			added = envelope
+			return 200, testResponse(action+"Response", `<h:AddAlarm_OUTPUT xmlns:h="`+amtAlarmClockService+`">`+
+				`<h:ReturnValue>0</h:ReturnValue></h:AddAlarm_OUTPUT>`)
+		case actionEnumerate:
+			return 200, testResponse(actionEnumerate+"Response", `<g:EnumerateResponse><g:EnumerationContext>1</g:EnumerationContext></g:EnumerateResponse>`)
+		case actionPull:
+			return 200, testResponse(actionPull+"Response", `<g:PullResponse><g:Items>`+
+				`<h:IPS_AlarmClockOccurrence xmlns:h="`+ipsAlarmClockOccurrence+`" xmlns:c="`+nsCIMCommon+`">`+
+				`<h:DeleteOnCompletion>false</h:DeleteOnCompletion><h:ElementName>amtgo-job-1</h:ElementName>`+
+				`<h:InstanceID>amtgo-job-1</h:InstanceID><h:Interval><c:Interval>P1D</c:Interval></h:Interval>`+
+				`<h:StartTime><c:Datetime>2017-03-14T07:00:00Z</c:Datetime></h:StartTime>`+
+				`</h:IPS_AlarmClockOccurrence></g:Items><g:EndOfSequence></g:EndOfSequence></g:PullResponse>`)
+		}
+		return 400, ""
+	})
+	defer server.Close()
+
+	alarms, err := client.Alarms()
+	if err != nil || len(alarms) != 1 {
+		t.Fatalf("Unexpected alarms %+v, %v", alarms, err)
+	}
+	if alarms[0].Name != "amtgo-job-1" || alarms[0].Interval != 24*time.Hour ||
+		!alarms[0].Start.Equal(time.Date(2017, 3, 14, 7, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected alarm %+v", alarms[0])
+	}
+
+	defer func() { PowerCapabilitiesStore = nil }()
+	PowerCapabilitiesStore = testCapabilitiesStore{"localhost": {Updated: int(time.Now().Unix()), AmtVersion: "11.0.25"}}
+	start := time.Date(2017, 3, 14, 8, 0, 0, 0, time.FixedZone("CET", 3600))
+	if err = client.AddAlarm(Alarm{Name: "wake", Start: start, Interval: 7 * 24 * time.Hour}); err != nil {
+		t.Fatalf("AddAlarm failed: %s", err)
+	}
+	for _, expected := range []string{
+		`<h:AlarmTemplate xmlns:q="` + ipsAlarmClockOccurrence + `" xmlns:cim="` + nsCIMCommon + `">`,
+		`<q:InstanceID>wake</q:InstanceID>`,
+		`<q:StartTime><cim:Datetime>2017-03-14T07:00:00Z</cim:Datetime></q:StartTime>`,
+		`<q:Interval><cim:Interval>P7DT0H0M</cim:Interval></q:Interval>`,
+	} {
+		if !strings.Contains(added, expected) {
+			t.Errorf("AddAlarm lacks %s:\n%s", expected, added)
+		}
+	}
+
+	PowerCapabilitiesStore = testCapabilitiesStore{"localhost": {Updated: int(time.Now().Unix()), AmtVersion: "9.5.30"}}
+	err = client.AddAlarm(Alarm{Name: "wake", Start: start})
+	if err == nil || !strings.Contains(err.Error(), "alarm clock not supported by host (AMT 9.5.30") {
+		t.Errorf("Expected unsupported alarm clock error, got %v", err)
+	}
+}
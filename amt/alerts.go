@@ -0,0 +1,239 @@
+package amt
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// WS-Eventing delivery mode used by Subscribe: AMT retries unacknowledged alerts
+const deliveryPushWithAck = "http://schemas.dmtf.org/wbem/wsman/1/wsman/PushWithAck"
+
+// actionAck acknowledges alerts delivered using deliveryPushWithAck
+const actionAck = "http://schemas.dmtf.org/wbem/wsman/1/wsman/Ack"
+
+// CIM_FilterCollection of all AMT alerts
+const alertFilterAll = "Intel(r) AMT:All"
+
+// Alert categories, as derived from an alert's message
+const (
+	AlertChassisIntrusion = "chassis-intrusion"
+	AlertBootFailure      = "boot-failure"
+	AlertHeartbeatLoss    = "heartbeat-loss"
+	AlertOther            = "other"
+)
+
+// alertCategoryKeywords maps alert categories to (lower case) message keywords
+var alertCategoryKeywords = []struct {
+	category string
+	keywords []string
+}{
+	{AlertChassisIntrusion, []string{"intrusion", "chassis", "opened"}},
+	{AlertBootFailure, []string{"boot fail", "no bootable", "boot error"}},
+	{AlertHeartbeatLoss, []string{"heartbeat", "agent presence"}},
+}
+
+// PerceivedSeverity of CIM_AlertIndication; alerts below aren't notified.
+const alertSeverityWarning = 3
+
+// Alert is an AMT alert (CIM_AlertIndication) pushed via WS-Eventing.
+type Alert struct {
+	ID        int    `json:"id"`
+	HostID    int    `json:"host_id" db:"host_id"`
+	AlertTime int    `json:"alert_time" db:"alert_time"`
+	MessageID string `json:"message_id" db:"message_id"`
+	Message   string `json:"message"`
+	Severity  int    `json:"severity"`
+	Category  string `json:"category"`
+}
+
+// Alerts is ember array of Alert
+type Alerts struct {
+	Alerts []Alert `json:"alerts"`
+}
+
+type subscribeBody struct {
+	XMLName  xml.Name `xml:"wse:Subscribe"`
+	XmlnsWse string   `xml:"xmlns:wse,attr"`
+	Delivery struct {
+		Mode     string `xml:"Mode,attr"`
+		NotifyTo string `xml:"wse:NotifyTo>wsa:Address"`
+	} `xml:"wse:Delivery"`
+}
+
+// ackEnvelope acknowledges a pushed alert.
+type ackEnvelope struct {
+	XMLName   xml.Name       `xml:"s:Envelope"`
+	XmlnsS    string         `xml:"xmlns:s,attr"`
+	XmlnsWsa  string         `xml:"xmlns:wsa,attr"`
+	Action    mustUnderstand `xml:"s:Header>wsa:Action"`
+	To        mustUnderstand `xml:"s:Header>wsa:To"`
+	RelatesTo string         `xml:"s:Header>wsa:RelatesTo"`
+	MessageID mustUnderstand `xml:"s:Header>wsa:MessageID"`
+	Body      string         `xml:"s:Body"`
+}
+
+// Subscribe makes the host push all its alerts to address.
+func (c *Client) Subscribe(address string) error {
+	body := subscribeBody{XmlnsWse: nsEventing}
+	body.Delivery.Mode = deliveryPushWithAck
+	body.Delivery.NotifyTo = address
+	_, err := c.Send(Request{
+		Action:      actionSubscribe,
+		ResourceURI: uriEventing,
+		Selectors:   []Selector{{"InstanceID", alertFilterAll}},
+		Body:        body,
+	})
+	return err
+}
+
+// Subscriptions returns the addresses alerts of the host are pushed to.
+func (c *Client) Subscriptions() ([]string, error) {
+	instances, err := c.Enumerate(cimListenerDestinationWSManagement)
+	if err != nil {
+		return nil, err
+	}
+	var addresses []string
+	for _, instance := range instances {
+		addresses = append(addresses, instance.Get("Destination"))
+	}
+	return addresses, nil
+}
+
+// Unsubscribe stops pushing alerts to address, deleting its listener
+// destination and thereby its subscriptions.
+func (c *Client) Unsubscribe(address string) error {
+	instances, err := c.Enumerate(cimListenerDestinationWSManagement)
+	if err != nil {
+		return err
+	}
+	for _, instance := range instances {
+		if instance.Get("Destination") != address {
+			continue
+		}
+		var selectors []Selector
+		for _, key := range []string{"CreationClassName", "Name", "SystemCreationClassName", "SystemName"} {
+			selectors = append(selectors, Selector{key, instance.Get(key)})
+		}
+		return c.Delete(cimListenerDestinationWSManagement, selectors)
+	}
+	return fmt.Errorf("no subscription for %s", address)
+}
+
+// AlertAddress returns the address alerts of hostname are pushed to,
+// given the base URL of the alert receiver.
+func AlertAddress(baseURL string, hostname string) string {
+	return strings.TrimSuffix(baseURL, "/") + "/" + hostname
+}
+
+// ParseAlert parses an alert pushed by AMT. It returns the alert and
+// the acknowledgement to send in response.
+func ParseAlert(data []byte) (Alert, []byte, error) {
+	response, err := parseResponse(200, data)
+	if err != nil {
+		return Alert{}, nil, err
+	}
+	var indication struct {
+		MessageID         string `xml:"MessageID"`
+		Message           string `xml:"Message"`
+		PerceivedSeverity int    `xml:"PerceivedSeverity"`
+		IndicationTime    string `xml:"IndicationTime>Datetime"`
+	}
+	if err = response.Decode(&indication); err != nil {
+		return Alert{}, nil, fmt.Errorf("cannot parse alert: %s", err)
+	}
+	alert := Alert{
+		MessageID: strings.TrimSpace(indication.MessageID),
+		Message:   strings.TrimSpace(indication.Message),
+		Severity:  indication.PerceivedSeverity,
+		AlertTime: int(time.Now().Unix()),
+	}
+	if t, err := time.Parse(time.RFC3339, strings.TrimSpace(indication.IndicationTime)); err == nil {
+		alert.AlertTime = int(t.Unix())
+	}
+	alert.Category = alertCategory(alert.Message)
+
+	ack, err := xml.Marshal(ackEnvelope{
+		XmlnsS:    nsSOAP,
+		XmlnsWsa:  nsAddressing,
+		Action:    mustUnderstand{actionAck, true},
+		To:        mustUnderstand{addressAnonymous, true},
+		RelatesTo: response.MessageID,
+		MessageID: mustUnderstand{newMessageID(), true},
+	})
+	return alert, append([]byte(xml.Header), ack...), err
+}
+
+// alertCategory derives the category of an alert from its message.
+func alertCategory(message string) string {
+	message = strings.ToLower(message)
+	for _, c := range alertCategoryKeywords {
+		for _, keyword := range c.keywords {
+			if strings.Contains(message, keyword) {
+				return c.category
+			}
+		}
+	}
+	return AlertOther
+}
+
+// Notify returns true if the alert deserves a notification.
+func (a Alert) Notify() bool {
+	return a.Category != AlertOther || a.Severity >= alertSeverityWarning
+}
+
+// CliSubscriptions prints the alert subscriptions of a list of hosts.
+func CliSubscriptions(hosts []string, format string, options Optionset) {
+	if len(hosts) == 0 {
+		fmt.Println("Error: Expected list of hostnames as arguments")
+		return
+	}
+	if format != FormatJSON && format != FormatTable {
+		fmt.Printf("Error: Unsupported output format %s\n", format)
+		return
+	}
+	options = cliOptions(options)
+
+	results := make(map[string][]string)
+	for _, host := range hosts {
+		addresses, err := NewClient(host, options).Subscriptions()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: Error: %s\n", host, err)
+			continue
+		}
+		results[host] = addresses
+	}
+
+	if format == FormatJSON {
+		data, _ := json.MarshalIndent(results, "", "  ")
+		fmt.Println(string(data))
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Host\tAlert destination")
+	for _, host := range hosts {
+		for _, address := range results[host] {
+			fmt.Fprintf(w, "%s\t%s\n", host, address)
+		}
+	}
+	w.Flush()
+}
+
+// CliSubscribe subscribes a list of hosts to push alerts to the alert
+// receiver at baseURL, or unsubscribes them if unsubscribe is set.
+func CliSubscribe(baseURL string, unsubscribe bool, hosts []string, options Optionset) {
+	if !strings.HasPrefix(baseURL, "http://") && !strings.HasPrefix(baseURL, "https://") {
+		fmt.Println("Error: Expected alert receiver URL, e.g. http://amtgo.example.com:8080/amt-events")
+		return
+	}
+	options.AlertURL = baseURL
+	cmd := CmdSubscribe
+	if unsubscribe {
+		cmd = CmdUnsubscribe
+	}
+	CliCommand(cmd, hosts, options)
+}
@@ -0,0 +1,103 @@
+package amt
+
+import (
+	"strings"
+	"testing"
+)
+
+const testAlert = `<?xml version="1.0" encoding="UTF-8"?>
+<a:Envelope xmlns:a="http://www.w3.org/2003/05/soap-envelope" xmlns:b="http://schemas.xmlsoap.org/ws/2004/08/addressing" xmlns:c="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd" xmlns:e="http://schemas.dmtf.org/wbem/wscim/1/common" xmlns:g="http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_AlertIndication">
+<a:Header><b:To>http://amtgo:8080/amt-events/host1</b:To>
+<b:Action a:mustUnderstand="true">http://schemas.dmtf.org/wbem/wsman/1/wsman/Event</b:Action>
+<b:MessageID>uuid:00000000-8086-8086-8086-00000000002A</b:MessageID>
+<c:AckRequested></c:AckRequested></a:Header>
+<a:Body><g:CIM_AlertIndication>
+<g:AlertType>8</g:AlertType>
+<g:IndicationTime><e:Datetime>2017-03-14T10:20:30Z</e:Datetime></g:IndicationTime>
+<g:Message>Physical Security: General Chassis Intrusion</g:Message>
+<g:MessageArguments>0</g:MessageArguments>
+<g:MessageID>iAMT0005</g:MessageID>
+<g:PerceivedSeverity>2</g:PerceivedSeverity>
+</g:CIM_AlertIndication></a:Body></a:Envelope>`
+
+func TestSubscribe(t *testing.T) {
+	var subscribe, del string
+	server, client := fakeAMT(t, func(action string, envelope string) (int, string) {
+		switch action {
+		case actionSubscribe:
+			subscribe = envelope
+			return 200, testResponse(nsEventing+"/SubscribeResponse", "")
+		case actionEnumerate:
+			return 200, testResponse(actionEnumerate+"Response", `<g:EnumerateResponse><g:EnumerationContext>1</g:EnumerationContext></g:EnumerateResponse>`)
+		case actionPull:
+			return 200, testResponse(actionPull+"Response", `<g:PullResponse><g:Items>`+
+				`<h:CIM_ListenerDestinationWSManagement xmlns:h="`+cimListenerDestinationWSManagement+`">`+
+				`<h:CreationClassName>CIM_ListenerDestinationWSManagement</h:CreationClassName>`+
+				`<h:Destination>http://amtgo:8080/amt-events/localhost</h:Destination>`+
+				`<h:Name>Intel(r) AMT:Listener Destination 0</h:Name>`+
+				`<h:SystemCreationClassName>CIM_ComputerSystem</h:SystemCreationClassName>`+
+				`<h:SystemName>Intel(r) AMT</h:SystemName>`+
+				`</h:CIM_ListenerDestinationWSManagement></g:Items><g:EndOfSequence></g:EndOfSequence></g:PullResponse>`)
+		case actionDelete:
+			del = envelope
+			return 200, testResponse(actionDelete+"Response", "")
+		}
+		return 400, ""
+	})
+	defer server.Close()
+
+	address := AlertAddress("http://amtgo:8080/amt-events/", client.Hostname)
+	if err := client.Subscribe(address); err != nil {
+		t.Fatalf("Subscribe failed: %s", err)
+	}
+	for _, expected := range []string{
+		`<wsman:Selector Name="InstanceID">Intel(r) AMT:All</wsman:Selector>`,
+		`<wse:Delivery Mode="` + deliveryPushWithAck + `">`,
+		`<wse:NotifyTo><wsa:Address>http://amtgo:8080/amt-events/localhost</wsa:Address></wse:NotifyTo>`,
+	} {
+		if !strings.Contains(subscribe, expected) {
+			t.Errorf("Subscribe lacks %s:\n%s", expected, subscribe)
+		}
+	}
+
+	addresses, err := client.Subscriptions()
+	if err != nil || len(addresses) != 1 || addresses[0] != address {
+		t.Errorf("Unexpected subscriptions %v, %v", addresses, err)
+	}
+	if err = client.Unsubscribe("http://elsewhere/"); err == nil {
+		t.Errorf("Expected error unsubscribing unknown address")
+	}
+	if err = client.Unsubscribe(address); err != nil {
+		t.Fatalf("Unsubscribe failed: %s", err)
+	}
+	if !strings.Contains(del, `<wsman:Selector Name="Name">Intel(r) AMT:Listener Destination 0</wsman:Selector>`) {
+		t.Errorf("Delete lacks listener destination selector:\n%s", del)
+	}
+}
+
+func TestParseAlert(t *testing.T) {
+	alert, ack, err := ParseAlert([]byte(testAlert))
+	if err != nil {
+		t.Fatalf("ParseAlert failed: %s", err)
+	}
+	if alert.MessageID != "iAMT0005" || alert.Category != AlertChassisIntrusion ||
+		alert.Severity != 2 || alert.AlertTime != 1489486830 || !alert.Notify() {
+		t.Errorf("Unexpected alert %+v", alert)
+	}
+	for _, expected := range []string{
+		`<wsa:Action s:mustUnderstand="true">` + actionAck + `</wsa:Action>`,
+		`<wsa:RelatesTo>uuid:00000000-8086-8086-8086-00000000002A</wsa:RelatesTo>`,
+	} {
+		if !strings.Contains(string(ack), expected) {
+			t.Errorf("Ack lacks %s:\n%s", expected, ack)
+		}
+	}
+
+	if alertCategory("Remote Control: Agent presence lost") != AlertHeartbeatLoss ||
+		alertCategory("BIOS: No bootable media found") != AlertBootFailure {
+		t.Errorf("Unexpected alert categories")
+	}
+	if (Alert{Category: AlertOther, Severity: 2}).Notify() {
+		t.Errorf("Informational alerts must not be notified")
+	}
+}
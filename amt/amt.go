@@ -2,14 +2,11 @@
 package amt
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net"
-	"regexp"
-	"strconv"
 	"time"
-
-	dac "github.com/schnoddelbotz/amtgo/amt/digest_auth_client"
 )
 
 // Verbose bool controls verbosity
@@ -31,15 +28,7 @@ func CliCommand(cmd string, hosts []string, options Optionset) {
 		fmt.Printf("%s for %s ...\n", cmd, hosts)
 	}
 
-	// hack: support legacy db model for optionsets via cli
-	if options.CliUseTLS {
-		options.SwUseTLS = 1
-	}
-	if options.CliSkipcertchk {
-		options.SwSkipcertchk = 1
-	} else if options.OptCacertfile != "" {
-		options.CaCertData = LoadCaCertFile(options.OptCacertfile)
-	}
+	options = cliOptions(options)
 
 	stateChannel := make(chan Laststate)
 	for _, host := range hosts {
@@ -53,99 +42,69 @@ func CliCommand(cmd string, hosts []string, options Optionset) {
 			}()
 		} else {
 			result = Command(client, cmd, options)
-			if result.StateHTTP != 0 {
-				result.Usermessage = fmt.Sprintf("%s S%d (%s)", httpReturncodeTextMap[result.StateHTTP],
-					result.StateAMT, legacyPowerstateTextMap[result.StateAMT])
-			}
-			fmt.Printf("%s %-15s OS:%-7s AMT:%02d HTTP:%03d %s\n", cmd, result.Hostname,
-				portMap[result.OpenPort], result.StateAMT, result.StateHTTP, result.Usermessage)
+			printResult(cmd, result)
 			time.Sleep(time.Duration(options.CliDelay) * time.Millisecond)
 		}
 	}
 
 	if cmd == CmdInfo {
 		for range hosts {
-			result := <-stateChannel
-			if result.StateHTTP != 0 {
-				result.Usermessage = fmt.Sprintf("%s S%d (%s)", httpReturncodeTextMap[result.StateHTTP],
-					result.StateAMT, legacyPowerstateTextMap[result.StateAMT])
-			}
-			fmt.Printf("%s %-15s OS:%-7s AMT:%02d HTTP:%03d %s\n", cmd, result.Hostname,
-				portMap[result.OpenPort], result.StateAMT, result.StateHTTP, result.Usermessage)
+			printResult(cmd, <-stateChannel)
 		}
 	}
 }
 
+func printResult(cmd string, result Laststate) {
+	message := result.Usermessage
+	if result.StateHTTP != 0 {
+		message = fmt.Sprintf("%s S%d (%s)", httpReturncodeTextMap[result.StateHTTP],
+			result.StateAMT, legacyPowerstateTextMap[result.StateAMT])
+		if cmd == CmdInfo {
+			message += fmt.Sprintf(" CIM:%d (%s)", result.StatePower, result.StatePowerText)
+		}
+		if result.Usermessage != "" {
+			message += ": " + result.Usermessage
+		}
+	}
+	fmt.Printf("%s %-15s OS:%-7s AMT:%02d HTTP:%03d %s\n", cmd, result.Hostname,
+		portMap[result.OpenPort], result.StateAMT, result.StateHTTP, message)
+}
+
 // Command executes a AMT command on a single host and returns execution result
 func Command(host Laststate, cmd string, options Optionset) (result Laststate) {
+	return CommandContext(context.Background(), host, cmd, options)
+}
+
+// CommandContext is like Command, but cancels AMT requests once ctx is done.
+// Errors are returned in result.Err, besides result.Usermessage.
+func CommandContext(ctx context.Context, host Laststate, cmd string, options Optionset) (result Laststate) {
 	command := cmdMap[cmd]
-	amtPort := "16992"
-	amtProto := "http"
-	amtTLSskipCertCheck := false
 	if Verbose {
 		fmt.Printf("start amt_command host %d: %s command: %s\n", host.HostID, host.Hostname, cmd)
 	}
-	if options.SwUseTLS == 1 {
-		amtPort = "16993"
-		amtProto = "https"
-	}
-	if options.SwSkipcertchk == 1 {
-		amtTLSskipCertCheck = true
-	}
 	result = host
-
-	cmdPayload, _ := Asset(command.CommandOne)
-	uri := amtProto + "://" + host.Hostname + ":" + amtPort + "/wsman"
-	dr := dac.NewRequest(options.Username, options.Password, "POST", uri,
-		string(cmdPayload), time.Duration(options.OptTimeout)*time.Second,
-		amtTLSskipCertCheck, options.CaCertData)
-
-	response1, err := dr.Execute()
-	var data string
-	if err != nil {
-		result.StateAMT = 16
-		result.StateHTTP = 0
-		result.Usermessage = err.Error()
+	client := NewClient(host.Hostname, options).WithContext(ctx)
+
+	var target WakeTarget
+	wakeable := wakesUp(cmd, options)
+	if wakeable {
+		// only power-up commands need the (database) lookup
+		target, wakeable = wakeTarget(host.Hostname)
+	}
+	if wakeable && target.Only {
+		result.Usermessage = "Wake-on-LAN sent (WoL only host)"
+		if err := WakeOnLAN(target.MACAddress, target.Broadcast); err != nil {
+			result.Usermessage = "Wake-on-LAN failed: " + err.Error()
+		}
 		return
 	}
 
-	result.StateHTTP = response1.StatusCode
-	bodyBytes, _ := ioutil.ReadAll(response1.Body)
-	data = string(bodyBytes)
-	response1.Body.Close()
-
-	if command.IsTwoStep && result.StateHTTP == 200 {
-		//fmt.Printf("STEP 2... start /w data: %s\n", data)
-		cmdPayload, _ = Asset(command.CommandTwo)
-		cmdPayload2 := string(cmdPayload)
-
-		if cmd == CmdInfo {
-			enumContext := getEnumContext(data)
-			if Verbose {
-				fmt.Printf("Using enumctx %s for %s\n", enumContext, host.Hostname)
-			}
-			cmdPayload2 = fmt.Sprintf(cmdPayload2, enumContext)
-		}
-
-		dr.UpdateRequest(options.Username, options.Password, "POST", uri,
-			string(cmdPayload2), time.Duration(options.OptTimeout)*time.Second,
-			amtTLSskipCertCheck, options.CaCertData)
-
-		response2, err := dr.Execute()
-		if err != nil {
-			result.Usermessage = err.Error()
-			result.StateAMT = 16
-			result.StateHTTP = 0
-			return
-		}
-
-		result.StateHTTP = response2.StatusCode
-		bodyBytes, _ := ioutil.ReadAll(response2.Body)
-		data = string(bodyBytes)
-		response2.Body.Close()
-
-		if cmd == CmdInfo && result.StateHTTP == 200 {
-			powerState := getPowerstate(data)
+	var err error
+	if cmd == CmdInfo {
+		var powerState int
+		powerState, err = client.PowerState()
+		if err == nil {
+			result.StatePower = powerState
 			result.StateAMT = legacyPowerstateMap[powerState]
 			if powerState == stateOn {
 				var probePorts []int
@@ -157,31 +116,72 @@ func Command(host Laststate, cmd string, options Optionset) (result Laststate) {
 				}
 				result.OpenPort = ProbeHostPorts(host.Hostname, probePorts)
 			}
+		} else if client.StatusCode == 200 {
+			result.StateAMT = 16
+		}
+		result.StatePowerText = PowerStateText(result.StatePower)
+	} else if run, ok := runMap[cmd]; ok {
+		err = run(client, options)
+	} else if supported, powerErr := client.powerCommand(cmd, options); powerErr != nil {
+		err = powerErr
+	} else {
+		if supported != cmd {
+			result.Usermessage = fmt.Sprintf("%s unsupported, fell back to %s", cmd, supported)
+			command = cmdMap[supported]
+		}
+		for _, request := range command.Steps {
+			if _, err = client.Send(request); err != nil {
+				break
+			}
 		}
 	}
 
+	result.StateHTTP = client.StatusCode
+	result.Err = err
+	if err != nil {
+		if client.StatusCode == 0 {
+			result.StateAMT = 16
+		}
+		result.Usermessage = err.Error()
+		if client.StatusCode == 0 && wakeable {
+			if wolErr := WakeOnLAN(target.MACAddress, target.Broadcast); wolErr != nil {
+				result.Usermessage += "; Wake-on-LAN failed: " + wolErr.Error()
+			} else {
+				result.Usermessage += "; sent Wake-on-LAN instead"
+			}
+		}
+	}
 	return
 }
 
 // SequentialCommand executes a command on hosts in sequential/non-parallel fashion.
-func SequentialCommand(cmd string, hosts []string, opt Optionset, delay float64) {
+func SequentialCommand(cmd string, hosts []string, opt Optionset, delay float64) []Laststate {
+	return SequentialCommandContext(context.Background(), cmd, hosts, opt, delay)
+}
+
+// SequentialCommandContext is like SequentialCommand, but stops once ctx is
+// done. It returns the results of hosts the command was run on.
+func SequentialCommandContext(ctx context.Context, cmd string, hosts []string, opt Optionset, delay float64) (results []Laststate) {
 	fmt.Printf("Running command: %s with delay %f on hosts: %s\n", cmd, delay, hosts)
 
-	for _, host := range hosts {
+	for i, host := range hosts {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				fmt.Printf("Command cancelled: %s\n", ctx.Err())
+				return
+			case <-time.After(time.Duration(delay * float64(time.Second))):
+			}
+		}
 		fmt.Printf("Running command: %s on host: %s\n", cmd, host)
 
 		var client Laststate
 		client.Hostname = host
-		Command(client, cmd, opt)
-		// if result.StateHTTP != 0 {
-		//  result.Usermessage = fmt.Sprintf("%s S%d (%s)", httpReturncodeTextMap[result.StateHTTP],
-		//    result.StateAMT, legacyPowerstateTextMap[result.StateAMT])
-		// }
-		time.Sleep(time.Duration(delay) * time.Second)
+		results = append(results, CommandContext(ctx, client, cmd, opt))
 	}
 
-	// FIXME: add record to notification table /w fails+success
 	fmt.Printf("Command completed.\n")
+	return
 }
 
 // ProbeHostPorts probes for given host ports.
@@ -199,31 +199,6 @@ func ProbeHostPorts(host string, ports []int) (openPort int) {
 	return
 }
 
-func getEnumContext(xmldata string) string {
-	// ...<g:EnumerationContext>06000000-0000-0000-0000-000000000000</g:EnumerationContext>...
-	regex := regexp.MustCompile(`<g:EnumerationContext>([^<]+)</g:EnumerationContext>`)
-	resultSlice := regex.FindStringSubmatch(xmldata)
-	if resultSlice != nil {
-		return resultSlice[1]
-	}
-	fmt.Println("ERROR: enum context regex -- no match in:", xmldata)
-	return ""
-}
-
-func getPowerstate(xmldata string) int {
-	// ...<h:PowerState>8</h:PowerState>
-	regex := regexp.MustCompile(`<h:PowerState>([^<]+)</h:PowerState>`)
-	resultSlice := regex.FindStringSubmatch(xmldata)
-	if resultSlice != nil {
-		if ps, err := strconv.Atoi(resultSlice[1]); err == nil {
-			return ps
-		}
-		return -1
-	}
-	fmt.Println("ERROR: could not find powerstate in:", xmldata)
-	return -2
-}
-
 // LoadCaCertFile loads a certificate from file and returns it as []byte
 func LoadCaCertFile(filename string) []byte {
 	data, err := ioutil.ReadFile(filename)
@@ -0,0 +1,325 @@
+package amt
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+)
+
+const amtAuditLog = uriAMT + "AMT_AuditLog"
+
+// SigningMechanism requested from ExportAuditLogSignature: RSA SHA-256
+const auditLogSigningMechanism = 1
+
+// Initiator types of audit records
+const (
+	InitiatorHTTPDigest = 0
+	InitiatorKerberos   = 1
+	InitiatorLocal      = 2
+	InitiatorKVMPort    = 3
+)
+
+// AuditRecord is a decoded AMT audit log record.
+// Record holds the raw (base64) record, as covered by the log signature.
+type AuditRecord struct {
+	ID             int    `json:"id"`
+	HostID         int    `json:"host_id" db:"host_id"`
+	AuditAppID     int    `json:"audit_app_id" db:"audit_app_id"`
+	EventID        int    `json:"event_id" db:"event_id"`
+	AuditApp       string `json:"audit_app" db:"audit_app"`
+	Event          string `json:"event"`
+	InitiatorType  int    `json:"initiator_type" db:"initiator_type"`
+	Initiator      string `json:"initiator"`
+	Time           int    `json:"time" db:"event_time"` // AMT local time as unix timestamp
+	MCLocationType int    `json:"mc_location_type" db:"mc_location_type"`
+	NetAddress     string `json:"net_address" db:"net_address"`
+	ExtendedData   string `json:"extended_data" db:"extended_data"` // hex
+	Record         string `json:"record"`
+}
+
+// AuditRecords is ember array of AuditRecord
+type AuditRecords struct {
+	AuditRecords []AuditRecord `json:"auditrecords"`
+}
+
+// AuditLogSignature is the AMT signature over all audit log records.
+type AuditLogSignature struct {
+	TotalRecordCount   int    `json:"total_record_count"`
+	StartLogTime       string `json:"start_log_time" xml:"StartLogTime>Datetime"`
+	EndLogTime         string `json:"end_log_time" xml:"EndLogTime>Datetime"`
+	GenerationTime     string `json:"generation_time" xml:"GenerationTime>Datetime"`
+	UUID               string `json:"uuid"`
+	FQDN               string `json:"fqdn"`
+	SignatureMechanism int    `json:"signature_mechanism"`
+	Signature          string `json:"signature"`    // base64
+	Certificates       string `json:"certificates"` // base64, DER
+	ReturnValue        int    `json:"-"`
+}
+
+// AuditLog holds the audit records of a host, plus signature if available.
+type AuditLog struct {
+	Hostname  string             `json:"hostname"`
+	Records   []AuditRecord      `json:"records"`
+	Signature *AuditLogSignature `json:"signature,omitempty"`
+}
+
+var auditAppTextMap = map[int]string{
+	16: "Security Admin",
+	17: "RCO",
+	18: "Redirection Manager",
+	19: "Firmware Update Manager",
+	20: "Security Audit Log",
+	21: "Network Time",
+	22: "Network Administration",
+	23: "Storage Administration",
+	24: "Event Manager",
+	25: "Circuit Breaker Manager",
+	26: "Agent Presence Manager",
+	27: "Wireless Configuration",
+	28: "EAC",
+	29: "KVM",
+	30: "User Opt-In Events",
+	32: "Screen Blanking",
+	33: "Watchdog Events",
+}
+
+// audit events by AuditAppID*100 + EventID
+var auditEventTextMap = map[int]string{
+	1600: "Provisioning Started",
+	1601: "Provisioning Completed",
+	1602: "ACL Entry Added",
+	1603: "ACL Entry Modified",
+	1604: "ACL Entry Removed",
+	1605: "ACL Access with Invalid Credentials",
+	1606: "ACL Entry State",
+	1607: "TLS State Changed",
+	1608: "TLS Server Certificate Set",
+	1609: "TLS Server Certificate Remove",
+	1610: "TLS Trusted Root Certificate Added",
+	1611: "TLS Trusted Root Certificate Removed",
+	1612: "TLS Preshared Key Set",
+	1613: "Kerberos Settings Modified",
+	1614: "Kerberos Master Key Modified",
+	1615: "Flash Wear out Counters Reset",
+	1616: "Power Package Modified",
+	1617: "Set Realm Authentication Mode",
+	1618: "Upgrade Client to Admin Control Mode",
+	1619: "Unprovisioning Started",
+	1700: "Performed Power Up",
+	1701: "Performed Power Down",
+	1702: "Performed Power Cycle",
+	1703: "Performed Reset",
+	1704: "Set Boot Options",
+	1800: "IDER Session Opened",
+	1801: "IDER Session Closed",
+	1802: "IDER Enabled",
+	1803: "IDER Disabled",
+	1804: "SoL Session Opened",
+	1805: "SoL Session Closed",
+	1806: "SoL Enabled",
+	1807: "SoL Disabled",
+	1808: "KVM Session Started",
+	1809: "KVM Session Ended",
+	1810: "KVM Enabled",
+	1811: "KVM Disabled",
+	1812: "VNC Password Failed 3 Times",
+	1900: "Firmware Updated",
+	1901: "Firmware Update Failed",
+	2000: "Security Audit Log Cleared",
+	2001: "Security Audit Policy Modified",
+	2002: "Security Audit Log Disabled",
+	2003: "Security Audit Log Enabled",
+	2004: "Security Audit Log Exported",
+	2005: "Security Audit Log Recovered",
+	2100: "Intel(R) ME Time Set",
+	2200: "TCPIP Parameters Set",
+	2201: "Host Name Set",
+	2202: "Domain Name Set",
+	2203: "VLAN Parameters Set",
+	2204: "Link Policy Set",
+	2205: "IPv6 Parameters Set",
+	2300: "Global Storage Attributes Set",
+	2301: "Storage EACL Modified",
+	2302: "Storage FPACL Modified",
+	2303: "Storage Write Operation",
+	2400: "Alert Subscribed",
+	2401: "Alert Unsubscribed",
+	2402: "Event Log Cleared",
+	2403: "Event Log Frozen",
+	2900: "KVM Session Started",
+	2901: "KVM Session Ended",
+	3000: "Opt-In Policy Change",
+	3001: "Send Consent Code Event",
+	3002: "Start Opt-In Blocked Event",
+}
+
+// DecodeAuditRecord decodes a raw audit log record.
+func DecodeAuditRecord(record []byte) (r AuditRecord, err error) {
+	r.Record = base64.StdEncoding.EncodeToString(record)
+	short := fmt.Errorf("audit record too short (%d bytes)", len(record))
+	if len(record) < 5 {
+		return r, short
+	}
+	r.AuditAppID = int(binary.BigEndian.Uint16(record[0:2]))
+	r.EventID = int(binary.BigEndian.Uint16(record[2:4]))
+	r.InitiatorType = int(record[4])
+	r.AuditApp = auditAppTextMap[r.AuditAppID]
+	r.Event = auditEventTextMap[r.AuditAppID*100+r.EventID]
+	if r.Event == "" {
+		r.Event = "#" + strconv.Itoa(r.EventID)
+	}
+
+	ptr := 5
+	switch r.InitiatorType {
+	case InitiatorHTTPDigest:
+		if len(record) < 6 || len(record) < 6+int(record[5]) {
+			return r, short
+		}
+		r.Initiator = string(record[6 : 6+int(record[5])])
+		ptr = 6 + int(record[5])
+	case InitiatorKerberos:
+		if len(record) < 10 || len(record) < 10+int(record[9]) {
+			return r, short
+		}
+		r.Initiator = "Kerberos SID " + hex.EncodeToString(record[10:10+int(record[9])])
+		ptr = 10 + int(record[9])
+	case InitiatorLocal:
+		r.Initiator = "Local"
+	case InitiatorKVMPort:
+		r.Initiator = "KVM Default Port"
+	}
+
+	if len(record) < ptr+6 {
+		return r, short
+	}
+	r.Time = int(binary.BigEndian.Uint32(record[ptr : ptr+4]))
+	r.MCLocationType = int(record[ptr+4])
+	netlen := int(record[ptr+5])
+	ptr += 6
+	if len(record) < ptr+netlen+1 {
+		return r, short
+	}
+	r.NetAddress = string(record[ptr : ptr+netlen])
+	ptr += netlen
+	exlen := int(record[ptr])
+	ptr++
+	if len(record) < ptr+exlen {
+		return r, short
+	}
+	r.ExtendedData = hex.EncodeToString(record[ptr : ptr+exlen])
+	return r, nil
+}
+
+// AuditLog reads all audit log records, paging through ReadRecords.
+// The log signature is included if AMT has audit log signing configured.
+func (c *Client) AuditLog() (AuditLog, error) {
+	log := AuditLog{Hostname: c.Hostname}
+	for startIndex := 1; ; {
+		var output struct {
+			TotalRecordCount int
+			RecordsReturned  int
+			EventRecords     []string
+			ReturnValue      int
+		}
+		err := c.Invoke(amtAuditLog, "ReadRecords", nil, &output,
+			Property{Name: "StartIndex", Value: strconv.Itoa(startIndex)})
+		if err != nil {
+			return log, err
+		}
+		if err = checkReturnValue("ReadRecords", output.ReturnValue); err != nil {
+			return log, err
+		}
+		for _, encoded := range output.EventRecords {
+			data, err := base64.StdEncoding.DecodeString(encoded)
+			if err != nil {
+				return log, err
+			}
+			record, err := DecodeAuditRecord(data)
+			if err != nil {
+				return log, err
+			}
+			log.Records = append(log.Records, record)
+		}
+		startIndex += output.RecordsReturned
+		if output.RecordsReturned == 0 || startIndex > output.TotalRecordCount {
+			break
+		}
+	}
+
+	var signature AuditLogSignature
+	err := c.Invoke(amtAuditLog, "ExportAuditLogSignature", nil, &signature,
+		Property{Name: "SigningMechanism", Value: strconv.Itoa(auditLogSigningMechanism)})
+	if err == nil {
+		err = checkReturnValue("ExportAuditLogSignature", signature.ReturnValue)
+	}
+	if err == nil {
+		log.Signature = &signature
+	} else if Verbose {
+		fmt.Printf("%s: no audit log signature: %s\n", c.Hostname, err)
+	}
+	return log, nil
+}
+
+// WriteAuditLogCSV writes records of given audit logs as CSV.
+func WriteAuditLogCSV(w io.Writer, logs []AuditLog) error {
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"hostname", "time", "audit_app", "event", "initiator", "net_address", "extended_data", "record"})
+	for _, log := range logs {
+		for _, r := range log.Records {
+			writer.Write([]string{log.Hostname, time.Unix(int64(r.Time), 0).UTC().Format(time.RFC3339),
+				r.AuditApp, r.Event, r.Initiator, r.NetAddress, r.ExtendedData, r.Record})
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// CliAuditLog prints the audit logs of a list of hosts.
+func CliAuditLog(hosts []string, format string, options Optionset) {
+	if len(hosts) == 0 {
+		fmt.Println("Error: Expected list of hostnames as arguments")
+		return
+	}
+	if format != FormatJSON && format != FormatTable && format != FormatCSV {
+		fmt.Printf("Error: Unsupported output format %s\n", format)
+		return
+	}
+	options = cliOptions(options)
+
+	var logs []AuditLog
+	for _, host := range hosts {
+		log, err := NewClient(host, options).AuditLog()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: Error: %s\n", host, err)
+			continue
+		}
+		logs = append(logs, log)
+	}
+
+	switch format {
+	case FormatJSON:
+		data, _ := json.MarshalIndent(logs, "", "  ")
+		fmt.Println(string(data))
+	case FormatCSV:
+		WriteAuditLogCSV(os.Stdout, logs)
+	default:
+		w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(w, "Host\tTime\tApplication\tEvent\tInitiator\tAddress")
+		for _, log := range logs {
+			for _, r := range log.Records {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", log.Hostname,
+					time.Unix(int64(r.Time), 0).UTC().Format("2006-01-02 15:04:05"),
+					r.AuditApp, r.Event, r.Initiator, r.NetAddress)
+			}
+		}
+		w.Flush()
+	}
+}
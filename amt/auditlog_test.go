@@ -0,0 +1,78 @@
+package amt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// "Performed Power Up" by digest user admin from 192.168.1.2
+var testAuditRecord = append([]byte{0x00, 0x11, 0x00, 0x00, 0x00, 0x05, 'a', 'd', 'm', 'i', 'n',
+	0x59, 0x8a, 0x5c, 0x10, 0x00, 0x0b}, append([]byte("192.168.1.2"), 0x01, 0x2a)...)
+
+func TestDecodeAuditRecord(t *testing.T) {
+	r, err := DecodeAuditRecord(testAuditRecord)
+	if err != nil {
+		t.Fatalf("DecodeAuditRecord failed: %s", err)
+	}
+	if r.AuditApp != "RCO" || r.Event != "Performed Power Up" || r.Initiator != "admin" {
+		t.Errorf("Unexpected audit record: %+v", r)
+	}
+	if r.Time != 0x598a5c10 || r.NetAddress != "192.168.1.2" || r.ExtendedData != "2a" {
+		t.Errorf("Unexpected audit record fields: %+v", r)
+	}
+	if r.Record != base64.StdEncoding.EncodeToString(testAuditRecord) {
+		t.Errorf("Unexpected raw record %s", r.Record)
+	}
+	if _, err = DecodeAuditRecord(testAuditRecord[:len(testAuditRecord)-1]); err == nil {
+		t.Errorf("DecodeAuditRecord accepted short record")
+	}
+}
+
+func TestAuditLog(t *testing.T) {
+	encoded := base64.StdEncoding.EncodeToString(testAuditRecord)
+	var startIndexes []string
+	server, client := fakeAMT(t, func(action string, envelope string) (int, string) {
+		switch action {
+		case amtAuditLog + "/ReadRecords":
+			start := envelope[strings.Index(envelope, "<h:StartIndex>")+14 : strings.Index(envelope, "</h:StartIndex>")]
+			startIndexes = append(startIndexes, start)
+			returned := 2
+			if start == "3" {
+				returned = 1
+			}
+			return 200, testResponse(action+"Response", `<h:ReadRecords_OUTPUT><h:TotalRecordCount>3</h:TotalRecordCount>`+
+				`<h:RecordsReturned>`+strconv.Itoa(returned)+`</h:RecordsReturned>`+
+				strings.Repeat(`<h:EventRecords>`+encoded+`</h:EventRecords>`, returned)+
+				`<h:ReturnValue>0</h:ReturnValue></h:ReadRecords_OUTPUT>`)
+		case amtAuditLog + "/ExportAuditLogSignature":
+			return 200, testResponse(action+"Response", `<h:ExportAuditLogSignature_OUTPUT>`+
+				`<h:ReturnValue>2075</h:ReturnValue></h:ExportAuditLogSignature_OUTPUT>`)
+		}
+		t.Errorf("Unexpected action %s", action)
+		return 400, ""
+	})
+	defer server.Close()
+
+	log, err := client.AuditLog()
+	if err != nil {
+		t.Fatalf("AuditLog failed: %s", err)
+	}
+	if len(log.Records) != 3 || strings.Join(startIndexes, ",") != "1,3" {
+		t.Errorf("Expected 3 records from start indexes 1,3, got %d from %v", len(log.Records), startIndexes)
+	}
+	if log.Signature != nil {
+		t.Errorf("Unexpected signature %+v", log.Signature)
+	}
+
+	var csv bytes.Buffer
+	if err = WriteAuditLogCSV(&csv, []AuditLog{log}); err != nil {
+		t.Fatalf("WriteAuditLogCSV failed: %s", err)
+	}
+	lines := strings.Split(strings.TrimSpace(csv.String()), "\n")
+	if len(lines) != 4 || !strings.Contains(lines[1], ",2017-08-09T00:49:20Z,RCO,Performed Power Up,admin,192.168.1.2,2a,") {
+		t.Errorf("Unexpected CSV output:\n%s", csv.String())
+	}
+}
@@ -0,0 +1,142 @@
+package amt
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Boot devices supported by Client.Boot
+const (
+	BootDevicePxe  = "pxe"
+	BootDeviceHdd  = "hdd"
+	BootDeviceCD   = "cd"
+	BootDeviceBIOS = "bios"
+)
+
+// BootDeviceShortMap as used by jobs' amtc_bootdevice in DB
+var BootDeviceShortMap = map[string]string{
+	"X": BootDevicePxe,
+	"H": BootDeviceHdd,
+	"C": BootDeviceCD,
+	"B": BootDeviceBIOS,
+}
+
+var bootSourceMap = map[string]string{
+	BootDevicePxe:  bootSourcePxe,
+	BootDeviceHdd:  bootSourceHdd,
+	BootDeviceCD:   bootSourceCD,
+	BootDeviceBIOS: "",
+}
+
+var bootSettingDataSelectors = []Selector{{"InstanceID", "Intel(r) AMT:BootSettingData 0"}}
+
+// AMT_BootSettingData properties AMT refuses to accept in Put requests
+var bootSettingDataReadOnly = map[string]bool{
+	"WinREBootEnabled":         true,
+	"UEFILocalPBABootEnabled":  true,
+	"UEFIHTTPSBootEnabled":     true,
+	"SecureBootControlEnabled": true,
+	"BootguardStatus":          true,
+	"OptionsCleared":           true,
+	"BIOSLastStatus":           true,
+	"UefiBootParametersArray":  true,
+	"UefiBootNumberOfParams":   true,
+	"RPEEnabled":               true,
+	"RSEPassword":              true,
+}
+
+// BootOptions control a one-time boot device selection.
+// Then is the power command issued afterwards (CmdUp, CmdReset, CmdCycle or empty).
+type BootOptions struct {
+	Device    string
+	Then      string
+	BIOSPause bool
+	UseSOL    bool
+	UseIDER   bool
+}
+
+// Validate checks boot options for unsupported combinations.
+func (b BootOptions) Validate() error {
+	if _, ok := bootSourceMap[b.Device]; !ok {
+		return fmt.Errorf("unsupported boot device '%s'", b.Device)
+	}
+	if b.Then != "" && b.Then != CmdUp && b.Then != CmdReset && b.Then != CmdCycle {
+		return fmt.Errorf("unsupported power command '%s' after boot configuration", b.Then)
+	}
+	if b.UseIDER && b.Device != BootDeviceCD {
+		return fmt.Errorf("IDER boot requires boot device %s", BootDeviceCD)
+	}
+	return nil
+}
+
+// Boot configures the next boot of the host as given by boot, then
+// issues the power command boot.Then, if any.
+func (c *Client) Boot(boot BootOptions) error {
+	if err := boot.Validate(); err != nil {
+		return err
+	}
+	var settings Instance
+	if err := c.Get(amtBootSettingData, bootSettingDataSelectors, &settings); err != nil {
+		return err
+	}
+	ider := "0"
+	if boot.UseIDER {
+		ider = "1" // CD
+	}
+	settings.Update(
+		Property{Name: "BIOSSetup", Value: strconv.FormatBool(boot.Device == BootDeviceBIOS)},
+		Property{Name: "BIOSPause", Value: strconv.FormatBool(boot.BIOSPause)},
+		Property{Name: "UseSOL", Value: strconv.FormatBool(boot.UseSOL)},
+		Property{Name: "UseIDER", Value: strconv.FormatBool(boot.UseIDER)},
+		Property{Name: "IDERBootDevice", Value: ider})
+	var properties []Property
+	for _, p := range settings.Properties {
+		if !bootSettingDataReadOnly[p.Name] {
+			properties = append(properties, p)
+		}
+	}
+	if err := c.Put(amtBootSettingData, bootSettingDataSelectors, nil, properties...); err != nil {
+		return err
+	}
+
+	source := bootSourceMap[boot.Device]
+	if boot.UseIDER {
+		source = "" // boot from IDER device configured above
+	}
+	steps := []Request{setBootConfigRole(), changeBootOrder(source)}
+	if boot.Then != "" {
+		steps = append(steps, cmdMap[boot.Then].Steps...)
+	}
+	for _, request := range steps {
+		response, err := c.Send(request)
+		if err != nil {
+			return err
+		}
+		var output struct{ ReturnValue int }
+		if err = response.Decode(&output); err != nil {
+			return err
+		}
+		if err = checkReturnValue(className(request.Action), output.ReturnValue); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BootThenMap maps CLI names of power commands issued after boot configuration
+var BootThenMap = map[string]string{
+	"":        "",
+	"powerup": CmdUp,
+	"reset":   CmdReset,
+	"cycle":   CmdCycle,
+}
+
+// CliBoot configures the next boot of a list of hosts.
+func CliBoot(boot BootOptions, hosts []string, options Optionset) {
+	if err := boot.Validate(); err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+	options.Boot = boot
+	CliCommand(CmdBoot, hosts, options)
+}
@@ -0,0 +1,53 @@
+package amt
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBoot(t *testing.T) {
+	var actions []string
+	server, client := fakeAMT(t, func(action string, envelope string) (int, string) {
+		actions = append(actions, className(action))
+		switch action {
+		case actionGet:
+			return 200, testResponse(action+"Response", `<g:AMT_BootSettingData xmlns:g="`+amtBootSettingData+`">`+
+				`<g:BIOSPause>false</g:BIOSPause><g:BIOSSetup>false</g:BIOSSetup><g:IDERBootDevice>0</g:IDERBootDevice>`+
+				`<g:InstanceID>Intel(r) AMT:BootSettingData 0</g:InstanceID><g:UEFIHTTPSBootEnabled>false</g:UEFIHTTPSBootEnabled>`+
+				`<g:UseIDER>false</g:UseIDER><g:UseSOL>false</g:UseSOL></g:AMT_BootSettingData>`)
+		case actionPut:
+			for _, expected := range []string{"<h:BIOSSetup>true</h:BIOSSetup>", "<h:UseSOL>true</h:UseSOL>",
+				"<h:InstanceID>Intel(r) AMT:BootSettingData 0</h:InstanceID>"} {
+				if !strings.Contains(envelope, expected) {
+					t.Errorf("Put lacks %s:\n%s", expected, envelope)
+				}
+			}
+			if strings.Contains(envelope, "UEFIHTTPSBootEnabled") {
+				t.Errorf("Put contains read-only property:\n%s", envelope)
+			}
+			return 200, testResponse(action+"Response", "")
+		case cimBootConfigSetting + "/ChangeBootOrder":
+			if strings.Contains(envelope, "Source") {
+				t.Errorf("ChangeBootOrder for BIOS setup has boot source:\n%s", envelope)
+			}
+		}
+		return 200, testResponse(action+"Response", `<h:OUTPUT><h:ReturnValue>0</h:ReturnValue></h:OUTPUT>`)
+	})
+	defer server.Close()
+
+	err := client.Boot(BootOptions{Device: BootDeviceBIOS, Then: CmdReset, UseSOL: true})
+	if err != nil {
+		t.Fatalf("Boot failed: %s", err)
+	}
+	expected := "Get,Put,SetBootConfigRole,ChangeBootOrder,RequestPowerStateChange"
+	if strings.Join(actions, ",") != expected {
+		t.Errorf("Expected actions %s, got %s", expected, strings.Join(actions, ","))
+	}
+
+	if err = client.Boot(BootOptions{Device: BootDevicePxe, UseIDER: true}); err == nil {
+		t.Errorf("Boot accepted IDER boot from PXE")
+	}
+	if err = client.Boot(BootOptions{Device: "floppy"}); err == nil {
+		t.Errorf("Boot accepted unsupported device")
+	}
+}
@@ -0,0 +1,220 @@
+package amt
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// PowerCapabilitiesMaxAge is the age after which cached power capabilities are refreshed
+const PowerCapabilitiesMaxAge = 7 * 24 * time.Hour
+
+// PowerCapabilities of a host, from CIM_PowerManagementCapabilities and the AMT version.
+// Capability lists are stored comma-separated.
+type PowerCapabilities struct {
+	HostID       int    `json:"host_id" db:"host_id"` // one record per host
+	Updated      int    `json:"updated"`
+	AmtVersion   string `json:"amt_version" db:"amt_version"`
+	Capabilities string `json:"power_change_capabilities" db:"power_change_capabilities"`
+	PowerStates  string `json:"power_states_supported" db:"power_states_supported"` // RequestPowerStateChange values
+}
+
+// PowerCapabilitiesStore caches power capabilities by hostname. If set
+// (the server uses the database), power commands don't query capabilities
+// of hosts with capabilities younger than PowerCapabilitiesMaxAge.
+var PowerCapabilitiesStore interface {
+	GetPowerCapabilities(hostname string) (PowerCapabilities, bool)
+	SavePowerCapabilities(hostname string, capabilities PowerCapabilities) error
+}
+
+// commandPowerStates maps power commands to their requested power state
+var commandPowerStates = map[string]int{
+	CmdUp:         requestPowerOn,
+	CmdDown:       requestPowerOffSoft,
+	CmdReset:      requestPowerMasterBusReset,
+	CmdReboot:     requestPowerMasterBusResetGraceful,
+	CmdShutdown:   requestPowerOffSoftGraceful,
+	CmdCycle:      requestPowerCycleOffSoft,
+	CmdSleep:      requestPowerSleepDeep,
+	CmdHibernate:  requestPowerHibernate,
+	CmdNMI:        requestPowerNMI,
+	CmdDiagnostic: requestPowerDiagnosticInterrupt,
+}
+
+// powerCommands lists power commands in the order shown by CliPowerCapabilities
+var powerCommands = []string{CmdUp, CmdDown, CmdReset, CmdReboot, CmdShutdown,
+	CmdCycle, CmdSleep, CmdHibernate, CmdNMI, CmdDiagnostic}
+
+// basicPowerCommands are supported by all AMT versions, so they are sent
+// without checking capabilities
+var basicPowerCommands = map[string]bool{CmdUp: true, CmdDown: true, CmdReset: true}
+
+// powerFallbackMap maps graceful power commands to their hard equivalent,
+// used if enabled by Optionset.SwFallback
+var powerFallbackMap = map[string]string{
+	CmdReboot:   CmdReset,
+	CmdShutdown: CmdDown,
+}
+
+// graceful power states require AMT 9.0+ (and an OS agent)
+const gracefulPowerStateMinVersion = 9
+
+// PowerCapabilities queries the power capabilities of the host.
+func (c *Client) PowerCapabilities() (PowerCapabilities, error) {
+	var capabilities PowerCapabilities
+	instances, err := c.Enumerate(ResourceURI("CIM_PowerManagementCapabilities"))
+	if err != nil {
+		return capabilities, err
+	}
+	for _, instance := range instances {
+		capabilities.Capabilities = strings.Join(instance.Values("PowerChangeCapabilities"), ",")
+		capabilities.PowerStates = strings.Join(instance.Values("PowerStatesSupported"), ",")
+	}
+	instances, err = c.Enumerate(ResourceURI("CIM_SoftwareIdentity"))
+	if err != nil {
+		return capabilities, err
+	}
+	for _, instance := range instances {
+		if instance.Get("InstanceID") == "AMT" {
+			capabilities.AmtVersion = instance.Get("VersionString")
+		}
+	}
+	capabilities.Updated = int(time.Now().Unix())
+	return capabilities, nil
+}
+
+// cachedPowerCapabilities returns power capabilities from PowerCapabilitiesStore,
+// querying and storing them if missing or outdated.
+func (c *Client) cachedPowerCapabilities() (PowerCapabilities, error) {
+	if PowerCapabilitiesStore != nil {
+		cached, ok := PowerCapabilitiesStore.GetPowerCapabilities(c.Hostname)
+		if ok && time.Since(time.Unix(int64(cached.Updated), 0)) < PowerCapabilitiesMaxAge {
+			return cached, nil
+		}
+	}
+	capabilities, err := c.PowerCapabilities()
+	if err != nil {
+		return capabilities, err
+	}
+	if PowerCapabilitiesStore != nil {
+		if err := PowerCapabilitiesStore.SavePowerCapabilities(c.Hostname, capabilities); err != nil && Verbose {
+			fmt.Printf("%s: saving power capabilities failed: %s\n", c.Hostname, err)
+		}
+	}
+	return capabilities, nil
+}
+
+// Supports returns true if the host supports requesting powerState.
+// If the host didn't report supported power states, graceful states
+// are assumed to require AMT 9.0+.
+func (p PowerCapabilities) Supports(powerState int) bool {
+	if p.PowerStates == "" {
+		if powerState == requestPowerOffSoftGraceful || powerState == requestPowerMasterBusResetGraceful {
+			major, err := amtMajorVersion(p.AmtVersion)
+			return err != nil || major >= gracefulPowerStateMinVersion
+		}
+		return true
+	}
+	for _, state := range strings.Split(p.PowerStates, ",") {
+		if state == strconv.Itoa(powerState) {
+			return true
+		}
+	}
+	return false
+}
+
+// PowerStatesText returns the supported power states as text.
+func (p PowerCapabilities) PowerStatesText() string {
+	var states []string
+	for _, state := range strings.Split(p.PowerStates, ",") {
+		value, err := strconv.Atoi(state)
+		if text, ok := powerstateTextMap[value]; ok && err == nil {
+			state = text
+		}
+		if state != "" {
+			states = append(states, state)
+		}
+	}
+	return strings.Join(states, ",")
+}
+
+// powerCommand checks whether the host supports power command cmd and
+// returns the command to run: cmd, or its fallback if the host doesn't
+// support cmd and fallback is enabled. Hosts not reporting capabilities
+// are assumed to support cmd. If the host is unreachable, the transport
+// error is returned, as cmd would fail the same way.
+func (c *Client) powerCommand(cmd string, options Optionset) (string, error) {
+	powerState, ok := commandPowerStates[cmd]
+	if !ok || basicPowerCommands[cmd] {
+		return cmd, nil
+	}
+	capabilities, err := c.cachedPowerCapabilities()
+	if err != nil {
+		if c.StatusCode == 0 {
+			return cmd, err
+		}
+		if Verbose {
+			fmt.Printf("%s: power capabilities unavailable: %s\n", c.Hostname, err)
+		}
+		return cmd, nil
+	}
+	if capabilities.Supports(powerState) {
+		return cmd, nil
+	}
+	fallback, ok := powerFallbackMap[cmd]
+	if ok && options.SwFallback == 1 && capabilities.Supports(commandPowerStates[fallback]) {
+		return fallback, nil
+	}
+	detail := fmt.Sprintf("(AMT %s, supports: %s)", capabilities.AmtVersion, capabilities.PowerStatesText())
+	if ok && options.SwFallback != 1 {
+		detail += fmt.Sprintf(" -- enable fallback to use %s instead", fallback)
+	}
+	return cmd, &ErrUnsupported{Feature: cmd, Detail: detail}
+}
+
+// CliPowerCapabilities prints the power capabilities of a list of hosts.
+func CliPowerCapabilities(hosts []string, format string, options Optionset) {
+	if len(hosts) == 0 {
+		fmt.Println("Error: Expected list of hostnames as arguments")
+		return
+	}
+	if format != FormatJSON && format != FormatTable {
+		fmt.Printf("Error: Unsupported output format %s\n", format)
+		return
+	}
+	options = cliOptions(options)
+
+	results := make(map[string]PowerCapabilities)
+	for _, host := range hosts {
+		capabilities, err := NewClient(host, options).PowerCapabilities()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: Error: %s\n", host, err)
+			continue
+		}
+		results[host] = capabilities
+	}
+
+	if format == FormatJSON {
+		data, _ := json.MarshalIndent(results, "", "  ")
+		fmt.Println(string(data))
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Host\tAMT\tSupported commands\tPower states")
+	for _, host := range hosts {
+		if p, ok := results[host]; ok {
+			var supported []string
+			for _, cmd := range powerCommands {
+				if p.Supports(commandPowerStates[cmd]) {
+					supported = append(supported, strings.ToLower(cmd))
+				}
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", host, p.AmtVersion, strings.Join(supported, ","), p.PowerStatesText())
+		}
+	}
+	w.Flush()
+}
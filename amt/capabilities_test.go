@@ -0,0 +1,83 @@
+package amt
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+type testCapabilitiesStore map[string]PowerCapabilities
+
+func (s testCapabilitiesStore) GetPowerCapabilities(hostname string) (PowerCapabilities, bool) {
+	capabilities, ok := s[hostname]
+	return capabilities, ok
+}
+
+func (s testCapabilitiesStore) SavePowerCapabilities(hostname string, capabilities PowerCapabilities) error {
+	s[hostname] = capabilities
+	return nil
+}
+
+func TestPowerCapabilitiesSupports(t *testing.T) {
+	old := PowerCapabilities{AmtVersion: "8.1.40", PowerStates: "2,5,8,10"}
+	if !old.Supports(requestPowerMasterBusReset) || old.Supports(requestPowerMasterBusResetGraceful) {
+		t.Errorf("Unexpected support of %+v", old)
+	}
+	if old.PowerStatesText() != "On,Power-Cycle-Off-Soft,Off-Soft,Master-Bus-Reset" {
+		t.Errorf("Unexpected power states text %s", old.PowerStatesText())
+	}
+	// no power states reported: graceful ones depend on AMT version
+	if (PowerCapabilities{AmtVersion: "8.1.40"}).Supports(requestPowerOffSoftGraceful) {
+		t.Errorf("AMT 8 must not support graceful shutdown")
+	}
+	if !(PowerCapabilities{AmtVersion: "11.0.25"}).Supports(requestPowerOffSoftGraceful) {
+		t.Errorf("AMT 11 must support graceful shutdown")
+	}
+}
+
+func TestPowerCommand(t *testing.T) {
+	defer func() { PowerCapabilitiesStore = nil }()
+	PowerCapabilitiesStore = testCapabilitiesStore{"old": {
+		Updated:     int(time.Now().Unix()),
+		AmtVersion:  "8.1.40",
+		PowerStates: "2,5,8,10",
+	}}
+	client := NewClient("old", Optionset{})
+
+	if cmd, err := client.powerCommand(CmdReset, Optionset{}); cmd != CmdReset || err != nil {
+		t.Errorf("Expected supported RESET, got %s, %v", cmd, err)
+	}
+	_, err := client.powerCommand(CmdReboot, Optionset{})
+	if err == nil || !strings.Contains(err.Error(), "REBOOT not supported by host (AMT 8.1.40") {
+		t.Errorf("Expected unsupported REBOOT error, got %v", err)
+	}
+	if cmd, err := client.powerCommand(CmdShutdown, Optionset{SwFallback: 1}); cmd != CmdDown || err != nil {
+		t.Errorf("Expected fallback to DOWN, got %s, %v", cmd, err)
+	}
+
+	// unreachable host: basic commands need no capabilities, others fail early
+	unreachable := NewClient("unreachable", Optionset{OptTimeout: 1})
+	unreachable.URL = "http://127.0.0.1:1/wsman"
+	if cmd, err := unreachable.powerCommand(CmdUp, Optionset{}); cmd != CmdUp || err != nil || unreachable.digest != nil {
+		t.Errorf("Expected UP without capability query, got %s, %v", cmd, err)
+	}
+	if _, err := unreachable.powerCommand(CmdCycle, Optionset{}); err == nil || unreachable.StatusCode != 0 {
+		t.Errorf("Expected transport error for CYCLE, got %v", err)
+	}
+}
+
+func TestPowerCommandsMapped(t *testing.T) {
+	for _, cmd := range powerCommands {
+		if _, ok := commandPowerStates[cmd]; !ok {
+			t.Errorf("Power command %s lacks requested power state", cmd)
+		}
+		if len(cmdMap[cmd].Steps) != 1 {
+			t.Errorf("Power command %s lacks RequestPowerStateChange step", cmd)
+		}
+	}
+	for short, cmd := range ShortCommandMap {
+		if _, ok := cmdMap[cmd]; !ok {
+			t.Errorf("Job command %s maps to unknown command %s", short, cmd)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package amt
+
+import "strconv"
+
+// Resource URI prefixes of CIM and AMT classes
+const (
+	uriCIM = "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/"
+	uriAMT = "http://intel.com/wbem/wscim/1/amt-schema/1/"
+	uriIPS = "http://intel.com/wbem/wscim/1/ips-schema/1/"
+)
+
+// resource URI of WS-Eventing subscriptions, filtered by CIM_FilterCollection
+const uriEventing = "http://schemas.dmtf.org/wbem/wscim/1/*"
+
+// Resource URIs used by amtgo commands
+const (
+	cimAssociatedPowerManagementService = uriCIM + "CIM_AssociatedPowerManagementService"
+	cimPowerManagementService           = uriCIM + "CIM_PowerManagementService"
+	cimComputerSystem                   = uriCIM + "CIM_ComputerSystem"
+	cimBootService                      = uriCIM + "CIM_BootService"
+	cimBootConfigSetting                = uriCIM + "CIM_BootConfigSetting"
+	cimBootSourceSetting                = uriCIM + "CIM_BootSourceSetting"
+	cimKVMRedirectionSAP                = uriCIM + "CIM_KVMRedirectionSAP"
+	cimListenerDestinationWSManagement  = uriCIM + "CIM_ListenerDestinationWSManagement"
+	amtBootSettingData                  = uriAMT + "AMT_BootSettingData"
+	amtGeneralSettings                  = uriAMT + "AMT_GeneralSettings"
+	amtWebUIService                     = uriAMT + "AMT_WebUIService"
+	amtRedirectionService               = uriAMT + "AMT_RedirectionService"
+	amtEthernetPortSettings             = uriAMT + "AMT_EthernetPortSettings"
+	amtTimeSynchronizationService       = uriAMT + "AMT_TimeSynchronizationService"
+	amtAuthorizationService             = uriAMT + "AMT_AuthorizationService"
+	amtPublicKeyManagementService       = uriAMT + "AMT_PublicKeyManagementService"
+	amtPublicPrivateKeyPair             = uriAMT + "AMT_PublicPrivateKeyPair"
+	amtPublicKeyCertificate             = uriAMT + "AMT_PublicKeyCertificate"
+	amtTLSCredentialContext             = uriAMT + "AMT_TLSCredentialContext"
+	amtTLSProtocolEndpointCollection    = uriAMT + "AMT_TLSProtocolEndpointCollection"
+	amtTLSSettingData                   = uriAMT + "AMT_TLSSettingData"
+	amtSetupAndConfigurationService     = uriAMT + "AMT_SetupAndConfigurationService"
+	amtAlarmClockService                = uriAMT + "AMT_AlarmClockService"
+	ipsKVMRedirectionSettingData        = uriIPS + "IPS_KVMRedirectionSettingData"
+	ipsOptInService                     = uriIPS + "IPS_OptInService"
+	ipsAlarmClockOccurrence             = uriIPS + "IPS_AlarmClockOccurrence"
+)
+
+// PowerState values for CIM_PowerManagementService.RequestPowerStateChange.
+// Note these are requested transitions, named like the resulting reported
+// power states (state* constants).
+const (
+	requestPowerOn                     = 2
+	requestPowerSleepDeep              = 4
+	requestPowerCycleOffSoft           = 5
+	requestPowerHibernate              = 7
+	requestPowerOffSoft                = 8
+	requestPowerMasterBusReset         = 10
+	requestPowerNMI                    = 11
+	requestPowerOffSoftGraceful        = 12
+	requestPowerMasterBusResetGraceful = 14
+	requestPowerDiagnosticInterrupt    = 17
+)
+
+// CIM_BootSourceSetting InstanceIDs
+const (
+	bootSourcePxe = "Intel(r) AMT: Force PXE Boot"
+	bootSourceHdd = "Intel(r) AMT: Force Hard-drive Boot"
+	bootSourceCD  = "Intel(r) AMT: Force CD/DVD Boot"
+)
+
+const bootConfigInstanceID = "Intel(r) AMT: Boot Configuration 0"
+
+var powerManagementServiceSelectors = []Selector{
+	{"Name", "Intel(r) AMT Power Management Service"},
+	{"SystemName", "Intel(r) AMT"},
+	{"CreationClassName", "CIM_PowerManagementService"},
+	{"SystemCreationClassName", "CIM_ComputerSystem"},
+}
+
+var managedSystem = EndpointReference{cimComputerSystem, []Selector{
+	{"Name", "ManagedSystem"},
+	{"CreationClassName", "CIM_ComputerSystem"},
+}}
+
+func invokeRequest(resourceURI string, method string, selectors []Selector, input ...Property) Request {
+	return Request{
+		Action:      resourceURI + "/" + method,
+		ResourceURI: resourceURI,
+		Selectors:   selectors,
+		Body:        InputBody(resourceURI, method, input...),
+	}
+}
+
+func putRequest(resourceURI string, selectors []Selector, properties ...Property) Request {
+	return Request{
+		Action:      actionPut,
+		ResourceURI: resourceURI,
+		Selectors:   selectors,
+		Body:        InstanceBody(resourceURI, properties...),
+	}
+}
+
+func requestPowerStateChange(powerState int) Request {
+	return invokeRequest(cimPowerManagementService, "RequestPowerStateChange", powerManagementServiceSelectors,
+		Property{Name: "PowerState", Value: strconv.Itoa(powerState)},
+		Property{Name: "ManagedElement", Ref: &managedSystem})
+}
+
+// changeBootOrder forces the next boot from bootSource; an empty
+// bootSource clears the boot order, leaving boot device choice to BIOS.
+func changeBootOrder(bootSource string) Request {
+	var input []Property
+	if bootSource != "" {
+		input = append(input, Property{Name: "Source",
+			Ref: &EndpointReference{cimBootSourceSetting, []Selector{{"InstanceID", bootSource}}}})
+	}
+	return invokeRequest(cimBootConfigSetting, "ChangeBootOrder",
+		[]Selector{{"InstanceID", bootConfigInstanceID}}, input...)
+}
+
+func setBootConfigRole() Request {
+	return invokeRequest(cimBootService, "SetBootConfigRole",
+		[]Selector{{"Name", "Intel(r) AMT Boot Service"}},
+		Property{Name: "BootConfigSetting", Ref: &EndpointReference{cimBootConfigSetting, []Selector{{"InstanceID", bootConfigInstanceID}}}},
+		Property{Name: "Role", Value: "1"})
+}
+
+func putPingResponse(enabled bool) Request {
+	return putRequest(amtGeneralSettings,
+		[]Selector{{"InstanceID", "Intel(r) AMT: General Settings"}},
+		Property{Name: "InstanceID", Value: "Intel(r) AMT: General Settings"},
+		Property{Name: "PingResponseEnabled", Value: strconv.FormatBool(enabled)})
+}
+
+func webUIStateChange(requestedState int) Request {
+	return invokeRequest(amtWebUIService, "RequestStateChange", nil,
+		Property{Name: "RequestedState", Value: strconv.Itoa(requestedState)})
+}
+
+func kvmStateChange(requestedState int) Request {
+	return invokeRequest(cimKVMRedirectionSAP, "RequestStateChange", nil,
+		Property{Name: "RequestedState", Value: strconv.Itoa(requestedState)})
+}
+
+func putRedirectionListener(enabled bool) Request {
+	return putRequest(amtRedirectionService,
+		[]Selector{
+			{"CreationClassName", "AMT_RedirectionService"},
+			{"Name", "Intel(r) AMT Redirection Service"},
+			{"SystemCreationClassName", "CIM_ComputerSystem"},
+			{"SystemName", "Intel(r) AMT"},
+		},
+		Property{Name: "CreationClassName", Value: "AMT_RedirectionService"},
+		Property{Name: "ElementName", Value: "Intel(r) AMT Redirection Service"},
+		Property{Name: "EnabledState", Value: "32770"},
+		Property{Name: "ListenerEnabled", Value: strconv.FormatBool(enabled)},
+		Property{Name: "Name", Value: "Intel(r) AMT Redirection Service"},
+		Property{Name: "SystemCreationClassName", Value: "CIM_ComputerSystem"},
+		Property{Name: "SystemName", Value: "Intel(r) AMT"})
+}
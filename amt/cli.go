@@ -0,0 +1,216 @@
+package amt
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+// Output formats supported by CLI commands; CSV is supported for audit logs only
+const (
+	FormatJSON  = "json"
+	FormatTable = "table"
+	FormatCSV   = "csv"
+)
+
+// WsmanArgs holds the arguments of generic wsman get/put/invoke commands.
+// Selectors and Params are given as Name=Value; Params take precedence
+// over properties read from InputFile (JSON or XML).
+type WsmanArgs struct {
+	Resource  string
+	Method    string
+	Selectors []string
+	Params    []string
+	InputFile string
+	Format    string
+}
+
+// cliOptions maps CLI flags onto the legacy db model for optionsets.
+func cliOptions(options Optionset) Optionset {
+	if options.CliUseTLS {
+		options.SwUseTLS = 1
+	}
+	if options.CliSkipcertchk {
+		options.SwSkipcertchk = 1
+	} else if options.OptCacertfile != "" {
+		options.CaCertData = LoadCaCertFile(options.OptCacertfile)
+	}
+	return options
+}
+
+// CliEnumerate enumerates resource (URI or class name) on a list of hosts
+// and prints all instances found in given format.
+func CliEnumerate(resource string, format string, hosts []string, options Optionset) {
+	if resource == "" || len(hosts) == 0 {
+		fmt.Println("Error: Expected resource URI and list of hostnames as arguments")
+		return
+	}
+	if format != FormatJSON && format != FormatTable {
+		fmt.Printf("Error: Unsupported output format %s\n", format)
+		return
+	}
+	options = cliOptions(options)
+
+	results := make(map[string][]Instance)
+	for _, host := range hosts {
+		instances, err := NewClient(host, options).Enumerate(ResourceURI(resource))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: Error: %s\n", host, err)
+			continue
+		}
+		results[host] = instances
+	}
+	printInstances(hosts, results, format)
+}
+
+// CliGet fetches and prints a single instance from each host.
+func CliGet(args WsmanArgs, hosts []string, options Optionset) {
+	cliWsman(args, hosts, options, func(c *Client, selectors []Selector, input []Property) (instance Instance, err error) {
+		err = c.Get(ResourceURI(args.Resource), selectors, &instance)
+		return
+	})
+}
+
+// CliPut modifies an instance on each host: it is fetched, updated
+// using the given properties and put back.
+func CliPut(args WsmanArgs, hosts []string, options Optionset) {
+	cliWsman(args, hosts, options, func(c *Client, selectors []Selector, input []Property) (instance Instance, err error) {
+		if len(input) == 0 {
+			return instance, fmt.Errorf("no properties to put given")
+		}
+		resourceURI := ResourceURI(args.Resource)
+		if err = c.Get(resourceURI, selectors, &instance); err != nil {
+			return
+		}
+		instance.Update(input...)
+		err = c.Put(resourceURI, selectors, &instance, instance.Properties...)
+		return
+	})
+}
+
+// CliInvoke invokes a method on each host and prints its output.
+func CliInvoke(args WsmanArgs, hosts []string, options Optionset) {
+	if args.Method == "" {
+		fmt.Println("Error: Expected method name")
+		return
+	}
+	cliWsman(args, hosts, options, func(c *Client, selectors []Selector, input []Property) (output Instance, err error) {
+		err = c.Invoke(ResourceURI(args.Resource), args.Method, selectors, &output, input...)
+		return
+	})
+}
+
+func cliWsman(args WsmanArgs, hosts []string, options Optionset,
+	run func(c *Client, selectors []Selector, input []Property) (Instance, error)) {
+	if args.Resource == "" || len(hosts) == 0 {
+		fmt.Println("Error: Expected resource URI and list of hostnames as arguments")
+		return
+	}
+	if args.Format != FormatJSON && args.Format != FormatTable {
+		fmt.Printf("Error: Unsupported output format %s\n", args.Format)
+		return
+	}
+	selectors, err := args.selectors()
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+	input, err := args.input()
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	options = cliOptions(options)
+	results := make(map[string][]Instance)
+	for _, host := range hosts {
+		instance, err := run(NewClient(host, options), selectors, input)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: Error: %s\n", host, err)
+			continue
+		}
+		results[host] = []Instance{instance}
+	}
+	printInstances(hosts, results, args.Format)
+}
+
+func (a WsmanArgs) selectors() (selectors []Selector, err error) {
+	for _, s := range a.Selectors {
+		kv := strings.SplitN(s, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("invalid selector %s, expected Name=Value", s)
+		}
+		selectors = append(selectors, Selector{kv[0], kv[1]})
+	}
+	return
+}
+
+func (a WsmanArgs) input() ([]Property, error) {
+	var input Instance
+	if a.InputFile != "" {
+		data, err := ioutil.ReadFile(a.InputFile)
+		if err != nil {
+			return nil, err
+		}
+		if input.Properties, err = ParseProperties(data); err != nil {
+			return nil, fmt.Errorf("%s: %s", a.InputFile, err)
+		}
+	}
+	var params []Property
+	for _, p := range a.Params {
+		kv := strings.SplitN(p, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("invalid parameter %s, expected Name=Value", p)
+		}
+		params = append(params, Property{Name: kv[0], Value: kv[1]})
+	}
+	input.Update(params...)
+	return input.Properties, nil
+}
+
+func printInstances(hosts []string, results map[string][]Instance, format string) {
+	if format == FormatJSON {
+		data, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+			return
+		}
+		fmt.Println(string(data))
+		return
+	}
+
+	var columns []string
+	seen := make(map[string]bool)
+	for _, host := range hosts {
+		for _, instance := range results[host] {
+			for _, name := range instance.Names() {
+				if !seen[name] {
+					seen[name] = true
+					columns = append(columns, name)
+				}
+			}
+		}
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "Host\tClass\t%s\n", strings.Join(columns, "\t"))
+	for _, host := range hosts {
+		for _, instance := range results[host] {
+			row := []string{host, instance.Class}
+			for _, name := range columns {
+				var values []string
+				for _, p := range instance.Properties {
+					if p.Name == name {
+						values = append(values, p.String())
+					}
+				}
+				row = append(row, strings.Join(values, ","))
+			}
+			fmt.Fprintln(w, strings.Join(row, "\t"))
+		}
+	}
+	w.Flush()
+}
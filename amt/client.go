@@ -0,0 +1,287 @@
+package amt
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	dac "github.com/schnoddelbotz/amtgo/amt/digest_auth_client"
+)
+
+// Client sends WS-MAN requests to a single AMT host.
+type Client struct {
+	Hostname   string
+	URL        string
+	StatusCode int // HTTP status of last response, 0 if none received
+	options    Optionset
+	digest     *dac.Client
+	ctx        context.Context
+}
+
+// digest clients unused for digestClientMaxIdle are dropped
+const digestClientMaxIdle = 10 * time.Minute
+
+// digestKey identifies a host's digest client by URL and TLS settings
+type digestKey struct {
+	url        string
+	timeout    time.Duration
+	skipVerify bool
+	caCert     [sha256.Size]byte // checksum of CA cert data
+}
+
+type digestEntry struct {
+	client *dac.Client
+	used   time.Time
+}
+
+// digest clients are kept per host, reusing connections and nonces
+// across Clients, e.g. of subsequent monitoring passes
+var (
+	digestClients      = make(map[digestKey]*digestEntry)
+	digestClientsLock  sync.Mutex
+	digestClientsSwept time.Time
+)
+
+// digestClient returns the digest client for url and options. Clients
+// are replaced if credentials change, e.g. by password rotation.
+func digestClient(url string, options Optionset) (*dac.Client, error) {
+	key := digestKey{url, time.Duration(options.OptTimeout) * time.Second,
+		options.SwSkipcertchk == 1, sha256.Sum256(options.CaCertData)}
+	now := time.Now()
+	digestClientsLock.Lock()
+	defer digestClientsLock.Unlock()
+	if now.Sub(digestClientsSwept) > time.Minute {
+		sweepDigestClients(now)
+	}
+	entry, ok := digestClients[key]
+	if ok && entry.client.Username == options.Username && entry.client.Password == options.Password {
+		entry.used = now
+		return entry.client, nil
+	}
+	client, err := dac.NewClient(options.Username, options.Password, key.timeout, key.skipVerify, options.CaCertData)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		entry.client.CloseIdleConnections()
+	}
+	digestClients[key] = &digestEntry{client, now}
+	return client, nil
+}
+
+// sweepDigestClients drops digest clients unused for digestClientMaxIdle.
+func sweepDigestClients(now time.Time) {
+	for key, entry := range digestClients {
+		if now.Sub(entry.used) > digestClientMaxIdle {
+			entry.client.CloseIdleConnections()
+			delete(digestClients, key)
+		}
+	}
+	digestClientsSwept = now
+}
+
+// NewClient returns a Client for hostname, using TLS settings and
+// credentials as given in options.
+func NewClient(hostname string, options Optionset) *Client {
+	amtPort := "16992"
+	amtProto := "http"
+	if options.SwUseTLS == 1 {
+		amtPort = "16993"
+		amtProto = "https"
+	}
+	return &Client{
+		Hostname: hostname,
+		URL:      amtProto + "://" + hostname + ":" + amtPort + "/wsman",
+		options:  options,
+	}
+}
+
+// WithContext returns a copy of c sending requests using ctx, which
+// cancels them once done.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	client := *c
+	client.ctx = ctx
+	return &client
+}
+
+// Send posts request r to the host and returns its response.
+// A SOAP fault is returned as *ErrSOAPFault error; non-200 responses
+// without fault result in an error, too (*ErrUnauthorized for 401).
+// In both cases, the response is returned. Failed requests result in
+// *ErrTimeout or *ErrTLSVerify errors, if applicable.
+func (c *Client) Send(r Request) (*Response, error) {
+	payload, err := r.Envelope(c.URL)
+	if err != nil {
+		return nil, err
+	}
+	if Verbose {
+		fmt.Printf("%s: %s %s\n", c.Hostname, className(r.ResourceURI), className(r.Action))
+	}
+
+	c.StatusCode = 0
+	if c.digest == nil {
+		if c.digest, err = digestClient(c.URL, c.options); err != nil {
+			return nil, transportError(err)
+		}
+	}
+	ctx := c.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	httpResponse, err := c.digest.DoContext(ctx, "POST", c.URL, string(payload))
+	if err != nil {
+		return nil, transportError(err)
+	}
+	data, err := ioutil.ReadAll(httpResponse.Body)
+	httpResponse.Body.Close()
+	c.StatusCode = httpResponse.StatusCode
+	if err != nil {
+		return &Response{StatusCode: c.StatusCode}, transportError(err)
+	}
+
+	if c.StatusCode == http.StatusUnauthorized {
+		return &Response{StatusCode: c.StatusCode}, &ErrUnauthorized{c.Hostname}
+	}
+	if len(data) == 0 && c.StatusCode != http.StatusOK {
+		return &Response{StatusCode: c.StatusCode}, fmt.Errorf("%s (HTTP %d)",
+			http.StatusText(c.StatusCode), c.StatusCode)
+	}
+	response, err := parseResponse(c.StatusCode, data)
+	if err == nil && c.StatusCode != http.StatusOK {
+		err = fmt.Errorf("%s (HTTP %d)", http.StatusText(c.StatusCode), c.StatusCode)
+	}
+	return response, err
+}
+
+// Get fetches a single instance of resourceURI and decodes it into v.
+func (c *Client) Get(resourceURI string, selectors []Selector, v interface{}) error {
+	response, err := c.Send(Request{Action: actionGet, ResourceURI: resourceURI, Selectors: selectors})
+	if err != nil {
+		return err
+	}
+	return response.Decode(v)
+}
+
+// Put replaces the instance of resourceURI by given properties.
+// The updated instance is decoded into v, if v is non-nil.
+func (c *Client) Put(resourceURI string, selectors []Selector, v interface{}, properties ...Property) error {
+	response, err := c.Send(putRequest(resourceURI, selectors, properties...))
+	if err != nil || v == nil {
+		return err
+	}
+	return response.Decode(v)
+}
+
+// Create creates an instance of resourceURI having given properties.
+func (c *Client) Create(resourceURI string, properties ...Property) error {
+	_, err := c.Send(Request{Action: actionCreate, ResourceURI: resourceURI, Body: InstanceBody(resourceURI, properties...)})
+	return err
+}
+
+// Delete deletes the instance of resourceURI identified by selectors.
+func (c *Client) Delete(resourceURI string, selectors []Selector) error {
+	_, err := c.Send(Request{Action: actionDelete, ResourceURI: resourceURI, Selectors: selectors})
+	return err
+}
+
+// modify updates properties of an instance of resourceURI, keeping all
+// other properties as they are.
+func (c *Client) modify(resourceURI string, selectors []Selector, properties ...Property) error {
+	var instance Instance
+	if err := c.Get(resourceURI, selectors, &instance); err != nil {
+		return err
+	}
+	instance.Update(properties...)
+	return c.Put(resourceURI, selectors, nil, instance.Properties...)
+}
+
+// Invoke calls method of resourceURI with given input properties.
+// The method's output is decoded into v, if v is non-nil.
+func (c *Client) Invoke(resourceURI string, method string, selectors []Selector, v interface{}, input ...Property) error {
+	response, err := c.Send(invokeRequest(resourceURI, method, selectors, input...))
+	if err != nil || v == nil {
+		return err
+	}
+	return response.Decode(v)
+}
+
+// enumerate runs a WS-MAN enumeration of resourceURI, following Pull
+// until EndOfSequence. It returns the raw XML of all received items.
+func (c *Client) enumerate(resourceURI string) ([]byte, error) {
+	response, err := c.Send(Request{
+		Action:      actionEnumerate,
+		ResourceURI: resourceURI,
+		Body:        enumerateBody{XmlnsWsen: nsEnumeration},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var enum enumerationResponse
+	if err = response.Decode(&enum); err != nil {
+		return nil, err
+	}
+
+	data := enum.Items.Content
+	for enum.EndOfSequence == nil {
+		if enum.EnumerationContext == "" {
+			return nil, fmt.Errorf("no enumeration context for %s", className(resourceURI))
+		}
+		response, err = c.Send(Request{
+			Action:      actionPull,
+			ResourceURI: resourceURI,
+			Body:        pullBody{XmlnsWsen: nsEnumeration, EnumerationContext: enum.EnumerationContext, MaxElements: 250},
+		})
+		if err != nil {
+			return nil, err
+		}
+		next := enumerationResponse{}
+		if err = response.Decode(&next); err != nil {
+			return nil, err
+		}
+		if next.EnumerationContext == "" {
+			next.EnumerationContext = enum.EnumerationContext
+		}
+		enum = next
+		data = append(data, enum.Items.Content...)
+	}
+	return data, nil
+}
+
+// Enumerate returns all instances of resourceURI.
+func (c *Client) Enumerate(resourceURI string) ([]Instance, error) {
+	data, err := c.enumerate(resourceURI)
+	if err != nil {
+		return nil, err
+	}
+	return parseInstances(data)
+}
+
+// PowerState queries the current CIM power state of the host.
+func (c *Client) PowerState() (int, error) {
+	data, err := c.enumerate(cimAssociatedPowerManagementService)
+	if err != nil {
+		return stateUnknown, err
+	}
+	var services struct {
+		PowerState []int `xml:"CIM_AssociatedPowerManagementService>PowerState"`
+	}
+	if err = decodeItems(data, &services); err != nil {
+		return stateUnknown, err
+	}
+	if len(services.PowerState) == 0 {
+		return stateUnknown, fmt.Errorf("could not find powerstate")
+	}
+	return services.PowerState[0], nil
+}
+
+// checkReturnValue turns a non-zero ReturnValue of method into an error.
+func checkReturnValue(method string, returnValue int) error {
+	if returnValue != 0 {
+		return fmt.Errorf("%s failed with return value %d", method, returnValue)
+	}
+	return nil
+}
@@ -1,616 +0,0 @@
-// Code generated by go-bindata.
-// sources:
-// amt-cmd/wsman_bootconfig
-// amt-cmd/wsman_down
-// amt-cmd/wsman_hddboot
-// amt-cmd/wsman_info
-// amt-cmd/wsman_info_step2
-// amt-cmd/wsman_ping_disable
-// amt-cmd/wsman_ping_enable
-// amt-cmd/wsman_pxeboot
-// amt-cmd/wsman_reset
-// amt-cmd/wsman_reset_graceful
-// amt-cmd/wsman_shutdown_graceful
-// amt-cmd/wsman_solredir_disable
-// amt-cmd/wsman_solredir_enable
-// amt-cmd/wsman_up
-// amt-cmd/wsman_webui_disable
-// amt-cmd/wsman_webui_enable
-// amt-cmd/wsman_xenum
-// amt-cmd/wsman_xenum_step2
-// DO NOT EDIT!
-
-package amt
-
-import (
-	"fmt"
-	"io/ioutil"
-	"os"
-	"path/filepath"
-	"reflect"
-	"strings"
-	"time"
-	"unsafe"
-)
-
-func bindataRead(data, name string) ([]byte, error) {
-	var empty [0]byte
-	sx := (*reflect.StringHeader)(unsafe.Pointer(&data))
-	b := empty[:]
-	bx := (*reflect.SliceHeader)(unsafe.Pointer(&b))
-	bx.Data = sx.Data
-	bx.Len = len(data)
-	bx.Cap = bx.Len
-	return b, nil
-}
-
-type asset struct {
-	bytes []byte
-	info  os.FileInfo
-}
-
-type bindataFileInfo struct {
-	name    string
-	size    int64
-	mode    os.FileMode
-	modTime time.Time
-}
-
-func (fi bindataFileInfo) Name() string {
-	return fi.name
-}
-func (fi bindataFileInfo) Size() int64 {
-	return fi.size
-}
-func (fi bindataFileInfo) Mode() os.FileMode {
-	return fi.mode
-}
-func (fi bindataFileInfo) ModTime() time.Time {
-	return fi.modTime
-}
-func (fi bindataFileInfo) IsDir() bool {
-	return false
-}
-func (fi bindataFileInfo) Sys() interface{} {
-	return nil
-}
-
-var _wsman_bootconfig = "\x3c\x3f\x78\x6d\x6c\x20\x76\x65\x72\x73\x69\x6f\x6e\x3d\x22\x31\x2e\x30\x22\x20\x65\x6e\x63\x6f\x64\x69\x6e\x67\x3d\x22\x55\x54\x46\x2d\x38\x22\x3f\x3e\x0a\x3c\x73\x3a\x45\x6e\x76\x65\x6c\x6f\x70\x65\x20\x78\x6d\x6c\x6e\x73\x3a\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x33\x2f\x30\x35\x2f\x73\x6f\x61\x70\x2d\x65\x6e\x76\x65\x6c\x6f\x70\x65\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x61\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x6d\x61\x6e\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x6e\x31\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x42\x6f\x6f\x74\x53\x65\x72\x76\x69\x63\x65\x22\x3e\x0a\x3c\x73\x3a\x48\x65\x61\x64\x65\x72\x3e\x0a\x3c\x77\x73\x61\x3a\x41\x63\x74\x69\x6f\x6e\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x42\x6f\x6f\x74\x53\x65\x72\x76\x69\x63\x65\x2f\x53\x65\x74\x42\x6f\x6f\x74\x43\x6f\x6e\x66\x69\x67\x52\x6f\x6c\x65\x3c\x2f\x77\x73\x61\x3a\x41\x63\x74\x69\x6f\x6e\x3e\x0a\x3c\x77\x73\x61\x3a\x54\x6f\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x31\x39\x32\x2e\x31\x36\x38\x2e\x30\x2e\x31\x30\x30\x3a\x31\x36\x39\x39\x32\x2f\x77\x73\x6d\x61\x6e\x3c\x2f\x77\x73\x61\x3a\x54\x6f\x3e\x0a\x3c\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x42\x6f\x6f\x74\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x0a\x3c\x77\x73\x61\x3a\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x75\x75\x69\x64\x3a\x34\x61\x36\x62\x66\x63\x66\x34\x2d\x63\x33\x36\x63\x2d\x34\x33\x30\x66\x2d\x62\x33\x63\x39\x2d\x32\x62\x37\x61\x30\x63\x36\x35\x66\x36\x35\x62\x3c\x2f\x77\x73\x61\x3a\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x3e\x0a\x3c\x77\x73\x61\x3a\x52\x65\x70\x6c\x79\x54\x6f\x3e\x3c\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x3c\x2f\x77\x73\x61\x3a\x52\x65\x70\x6c\x79\x54\x6f\x3e\x0a\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x4e\x61\x6d\x65\x22\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x20\x42\x6f\x6f\x74\x20\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x3c\x2f\x73\x3a\x48\x65\x61\x64\x65\x72\x3e\x0a\x3c\x73\x3a\x42\x6f\x64\x79\x3e\x0a\x3c\x6e\x31\x3a\x53\x65\x74\x42\x6f\x6f\x74\x43\x6f\x6e\x66\x69\x67\x52\x6f\x6c\x65\x5f\x49\x4e\x50\x55\x54\x3e\x0a\x20\x20\x3c\x6e\x31\x3a\x42\x6f\x6f\x74\x43\x6f\x6e\x66\x69\x67\x53\x65\x74\x74\x69\x6e\x67\x3e\x0a\x20\x20\x20\x20\x3c\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x0a\x20\x20\x20\x20\x3c\x77\x73\x61\x3a\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x50\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x42\x6f\x6f\x74\x43\x6f\x6e\x66\x69\x67\x53\x65\x74\x74\x69\x6e\x67\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x77\x73\x6d\x61\x6e\x3a\x4e\x61\x6d\x65\x3d\x22\x49\x6e\x73\x74\x61\x6e\x63\x65\x49\x44\x22\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x3a\x20\x42\x6f\x6f\x74\x20\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x20\x30\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x77\x73\x61\x3a\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x50\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3e\x0a\x20\x20\x3c\x2f\x6e\x31\x3a\x42\x6f\x6f\x74\x43\x6f\x6e\x66\x69\x67\x53\x65\x74\x74\x69\x6e\x67\x3e\x0a\x20\x20\x3c\x6e\x31\x3a\x52\x6f\x6c\x65\x3e\x31\x3c\x2f\x6e\x31\x3a\x52\x6f\x6c\x65\x3e\x0a\x3c\x2f\x6e\x31\x3a\x53\x65\x74\x42\x6f\x6f\x74\x43\x6f\x6e\x66\x69\x67\x52\x6f\x6c\x65\x5f\x49\x4e\x50\x55\x54\x3e\x0a\x3c\x2f\x73\x3a\x42\x6f\x64\x79\x3e\x3c\x2f\x73\x3a\x45\x6e\x76\x65\x6c\x6f\x70\x65\x3e"
-
-func wsman_bootconfigBytes() ([]byte, error) {
-	return bindataRead(
-		_wsman_bootconfig,
-		"wsman_bootconfig",
-	)
-}
-
-func wsman_bootconfig() (*asset, error) {
-	bytes, err := wsman_bootconfigBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "wsman_bootconfig", size: 1579, mode: os.FileMode(420), modTime: time.Unix(1491066347, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _wsman_down = "\x3c\x3f\x78\x6d\x6c\x20\x76\x65\x72\x73\x69\x6f\x6e\x3d\x22\x31\x2e\x30\x22\x20\x65\x6e\x63\x6f\x64\x69\x6e\x67\x3d\x22\x55\x54\x46\x2d\x38\x22\x3f\x3e\x0a\x3c\x73\x3a\x45\x6e\x76\x65\x6c\x6f\x70\x65\x20\x78\x6d\x6c\x6e\x73\x3a\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x33\x2f\x30\x35\x2f\x73\x6f\x61\x70\x2d\x65\x6e\x76\x65\x6c\x6f\x70\x65\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x61\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x6d\x61\x6e\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x6e\x31\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x50\x6f\x77\x65\x72\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x53\x65\x72\x76\x69\x63\x65\x22\x3e\x0a\x3c\x73\x3a\x48\x65\x61\x64\x65\x72\x3e\x0a\x20\x20\x3c\x77\x73\x61\x3a\x41\x63\x74\x69\x6f\x6e\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x50\x6f\x77\x65\x72\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x53\x65\x72\x76\x69\x63\x65\x2f\x52\x65\x71\x75\x65\x73\x74\x50\x6f\x77\x65\x72\x53\x74\x61\x74\x65\x43\x68\x61\x6e\x67\x65\x3c\x2f\x77\x73\x61\x3a\x41\x63\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x3c\x77\x73\x61\x3a\x54\x6f\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x31\x39\x32\x2e\x31\x36\x38\x2e\x30\x2e\x31\x30\x30\x3a\x31\x36\x39\x39\x32\x2f\x77\x73\x6d\x61\x6e\x3c\x2f\x77\x73\x61\x3a\x54\x6f\x3e\x0a\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x50\x6f\x77\x65\x72\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x0a\x20\x20\x3c\x77\x73\x61\x3a\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x75\x75\x69\x64\x3a\x65\x63\x36\x32\x30\x39\x34\x62\x2d\x65\x32\x34\x31\x2d\x34\x63\x39\x61\x2d\x62\x63\x34\x37\x2d\x36\x63\x32\x64\x35\x37\x38\x38\x61\x35\x37\x30\x3c\x2f\x77\x73\x61\x3a\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x3e\x0a\x20\x20\x3c\x77\x73\x61\x3a\x52\x65\x70\x6c\x79\x54\x6f\x3e\x3c\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x3c\x2f\x77\x73\x61\x3a\x52\x65\x70\x6c\x79\x54\x6f\x3e\x0a\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x4e\x61\x6d\x65\x22\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x20\x50\x6f\x77\x65\x72\x20\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x20\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x53\x79\x73\x74\x65\x6d\x4e\x61\x6d\x65\x22\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x22\x3e\x43\x49\x4d\x5f\x50\x6f\x77\x65\x72\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x53\x79\x73\x74\x65\x6d\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x22\x3e\x43\x49\x4d\x5f\x43\x6f\x6d\x70\x75\x74\x65\x72\x53\x79\x73\x74\x65\x6d\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x3c\x2f\x73\x3a\x48\x65\x61\x64\x65\x72\x3e\x0a\x3c\x73\x3a\x42\x6f\x64\x79\x3e\x0a\x20\x20\x3c\x6e\x31\x3a\x52\x65\x71\x75\x65\x73\x74\x50\x6f\x77\x65\x72\x53\x74\x61\x74\x65\x43\x68\x61\x6e\x67\x65\x5f\x49\x4e\x50\x55\x54\x3e\x0a\x20\x20\x20\x20\x3c\x6e\x31\x3a\x50\x6f\x77\x65\x72\x53\x74\x61\x74\x65\x3e\x38\x3c\x2f\x6e\x31\x3a\x50\x6f\x77\x65\x72\x53\x74\x61\x74\x65\x3e\x0a\x20\x20\x20\x20\x3c\x6e\x31\x3a\x4d\x61\x6e\x61\x67\x65\x64\x45\x6c\x65\x6d\x65\x6e\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x61\x3a\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x50\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x43\x6f\x6d\x70\x75\x74\x65\x72\x53\x79\x73\x74\x65\x6d\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x4e\x61\x6d\x65\x22\x3e\x4d\x61\x6e\x61\x67\x65\x64\x53\x79\x73\x74\x65\x6d\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x22\x3e\x43\x49\x4d\x5f\x43\x6f\x6d\x70\x75\x74\x65\x72\x53\x79\x73\x74\x65\x6d\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x77\x73\x61\x3a\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x50\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x6e\x31\x3a\x4d\x61\x6e\x61\x67\x65\x64\x45\x6c\x65\x6d\x65\x6e\x74\x3e\x0a\x20\x20\x3c\x2f\x6e\x31\x3a\x52\x65\x71\x75\x65\x73\x74\x50\x6f\x77\x65\x72\x53\x74\x61\x74\x65\x43\x68\x61\x6e\x67\x65\x5f\x49\x4e\x50\x55\x54\x3e\x0a\x3c\x2f\x73\x3a\x42\x6f\x64\x79\x3e\x0a\x3c\x2f\x73\x3a\x45\x6e\x76\x65\x6c\x6f\x70\x65\x3e\x0a"
-
-func wsman_downBytes() ([]byte, error) {
-	return bindataRead(
-		_wsman_down,
-		"wsman_down",
-	)
-}
-
-func wsman_down() (*asset, error) {
-	bytes, err := wsman_downBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "wsman_down", size: 1985, mode: os.FileMode(420), modTime: time.Unix(1491066347, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _wsman_hddboot = "\x3c\x3f\x78\x6d\x6c\x20\x76\x65\x72\x73\x69\x6f\x6e\x3d\x22\x31\x2e\x30\x22\x20\x65\x6e\x63\x6f\x64\x69\x6e\x67\x3d\x22\x55\x54\x46\x2d\x38\x22\x3f\x3e\x0a\x3c\x73\x3a\x45\x6e\x76\x65\x6c\x6f\x70\x65\x20\x78\x6d\x6c\x6e\x73\x3a\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x33\x2f\x30\x35\x2f\x73\x6f\x61\x70\x2d\x65\x6e\x76\x65\x6c\x6f\x70\x65\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x61\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x6d\x61\x6e\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x6e\x31\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x42\x6f\x6f\x74\x43\x6f\x6e\x66\x69\x67\x53\x65\x74\x74\x69\x6e\x67\x22\x3e\x0a\x3c\x73\x3a\x48\x65\x61\x64\x65\x72\x3e\x0a\x3c\x77\x73\x61\x3a\x41\x63\x74\x69\x6f\x6e\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x42\x6f\x6f\x74\x43\x6f\x6e\x66\x69\x67\x53\x65\x74\x74\x69\x6e\x67\x2f\x43\x68\x61\x6e\x67\x65\x42\x6f\x6f\x74\x4f\x72\x64\x65\x72\x3c\x2f\x77\x73\x61\x3a\x41\x63\x74\x69\x6f\x6e\x3e\x0a\x3c\x77\x73\x61\x3a\x54\x6f\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x31\x39\x32\x2e\x31\x36\x38\x2e\x30\x2e\x31\x30\x30\x3a\x31\x36\x39\x39\x32\x2f\x77\x73\x6d\x61\x6e\x3c\x2f\x77\x73\x61\x3a\x54\x6f\x3e\x0a\x3c\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x42\x6f\x6f\x74\x43\x6f\x6e\x66\x69\x67\x53\x65\x74\x74\x69\x6e\x67\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x0a\x3c\x77\x73\x61\x3a\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x75\x75\x69\x64\x3a\x34\x65\x62\x65\x30\x38\x34\x30\x2d\x63\x38\x32\x64\x2d\x34\x63\x62\x38\x2d\x39\x62\x31\x38\x2d\x36\x61\x64\x36\x37\x37\x37\x63\x30\x64\x64\x37\x3c\x2f\x77\x73\x61\x3a\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x3e\x0a\x3c\x77\x73\x61\x3a\x52\x65\x70\x6c\x79\x54\x6f\x3e\x0a\x20\x20\x3c\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x0a\x3c\x2f\x77\x73\x61\x3a\x52\x65\x70\x6c\x79\x54\x6f\x3e\x0a\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x49\x6e\x73\x74\x61\x6e\x63\x65\x49\x44\x22\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x3a\x20\x42\x6f\x6f\x74\x20\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x20\x30\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x3c\x2f\x73\x3a\x48\x65\x61\x64\x65\x72\x3e\x0a\x3c\x73\x3a\x42\x6f\x64\x79\x3e\x0a\x20\x20\x3c\x6e\x31\x3a\x43\x68\x61\x6e\x67\x65\x42\x6f\x6f\x74\x4f\x72\x64\x65\x72\x5f\x49\x4e\x50\x55\x54\x3e\x0a\x20\x20\x20\x20\x3c\x6e\x31\x3a\x53\x6f\x75\x72\x63\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x61\x3a\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x50\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x42\x6f\x6f\x74\x53\x6f\x75\x72\x63\x65\x53\x65\x74\x74\x69\x6e\x67\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x77\x73\x6d\x61\x6e\x3a\x4e\x61\x6d\x65\x3d\x22\x49\x6e\x73\x74\x61\x6e\x63\x65\x49\x44\x22\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x3a\x20\x46\x6f\x72\x63\x65\x20\x48\x61\x72\x64\x2d\x64\x72\x69\x76\x65\x20\x42\x6f\x6f\x74\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x77\x73\x61\x3a\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x50\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x6e\x31\x3a\x53\x6f\x75\x72\x63\x65\x3e\x0a\x20\x20\x3c\x2f\x6e\x31\x3a\x43\x68\x61\x6e\x67\x65\x42\x6f\x6f\x74\x4f\x72\x64\x65\x72\x5f\x49\x4e\x50\x55\x54\x3e\x0a\x3c\x2f\x73\x3a\x42\x6f\x64\x79\x3e\x3c\x2f\x73\x3a\x45\x6e\x76\x65\x6c\x6f\x70\x65\x3e"
-
-func wsman_hddbootBytes() ([]byte, error) {
-	return bindataRead(
-		_wsman_hddboot,
-		"wsman_hddboot",
-	)
-}
-
-func wsman_hddboot() (*asset, error) {
-	bytes, err := wsman_hddbootBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "wsman_hddboot", size: 1588, mode: os.FileMode(420), modTime: time.Unix(1491066347, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _wsman_info = "\x3c\x3f\x78\x6d\x6c\x20\x76\x65\x72\x73\x69\x6f\x6e\x3d\x22\x31\x2e\x30\x22\x20\x65\x6e\x63\x6f\x64\x69\x6e\x67\x3d\x22\x55\x54\x46\x2d\x38\x22\x3f\x3e\x0a\x3c\x73\x3a\x45\x6e\x76\x65\x6c\x6f\x70\x65\x20\x78\x6d\x6c\x6e\x73\x3a\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x33\x2f\x30\x35\x2f\x73\x6f\x61\x70\x2d\x65\x6e\x76\x65\x6c\x6f\x70\x65\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x61\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x6d\x61\x6e\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x65\x6e\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x39\x2f\x65\x6e\x75\x6d\x65\x72\x61\x74\x69\x6f\x6e\x22\x3e\x3c\x73\x3a\x48\x65\x61\x64\x65\x72\x3e\x3c\x77\x73\x61\x3a\x41\x63\x74\x69\x6f\x6e\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x39\x2f\x65\x6e\x75\x6d\x65\x72\x61\x74\x69\x6f\x6e\x2f\x45\x6e\x75\x6d\x65\x72\x61\x74\x65\x3c\x2f\x77\x73\x61\x3a\x41\x63\x74\x69\x6f\x6e\x3e\x3c\x77\x73\x61\x3a\x54\x6f\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x31\x39\x32\x2e\x31\x36\x38\x2e\x30\x2e\x31\x30\x30\x3a\x31\x36\x39\x39\x32\x2f\x77\x73\x6d\x61\x6e\x3c\x2f\x77\x73\x61\x3a\x54\x6f\x3e\x3c\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x41\x73\x73\x6f\x63\x69\x61\x74\x65\x64\x50\x6f\x77\x65\x72\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x3c\x77\x73\x61\x3a\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x75\x75\x69\x64\x3a\x64\x66\x39\x32\x37\x31\x36\x34\x2d\x38\x36\x34\x31\x2d\x34\x36\x34\x61\x2d\x38\x61\x37\x36\x2d\x32\x30\x62\x62\x32\x63\x35\x34\x63\x32\x32\x33\x3c\x2f\x77\x73\x61\x3a\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x3e\x3c\x77\x73\x61\x3a\x52\x65\x70\x6c\x79\x54\x6f\x3e\x3c\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x3c\x2f\x77\x73\x61\x3a\x52\x65\x70\x6c\x79\x54\x6f\x3e\x3c\x2f\x73\x3a\x48\x65\x61\x64\x65\x72\x3e\x3c\x73\x3a\x42\x6f\x64\x79\x3e\x3c\x77\x73\x65\x6e\x3a\x45\x6e\x75\x6d\x65\x72\x61\x74\x65\x3e\x3c\x77\x73\x6d\x61\x6e\x3a\x4f\x70\x74\x69\x6d\x69\x7a\x65\x45\x6e\x75\x6d\x65\x72\x61\x74\x69\x6f\x6e\x2f\x3e\x3c\x77\x73\x6d\x61\x6e\x3a\x45\x6e\x75\x6d\x65\x72\x61\x74\x69\x6f\x6e\x4d\x6f\x64\x65\x3e\x45\x6e\x75\x6d\x65\x72\x61\x74\x65\x4f\x62\x6a\x65\x63\x74\x41\x6e\x64\x45\x50\x52\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x45\x6e\x75\x6d\x65\x72\x61\x74\x69\x6f\x6e\x4d\x6f\x64\x65\x3e\x3c\x77\x73\x6d\x61\x6e\x3a\x4d\x61\x78\x45\x6c\x65\x6d\x65\x6e\x74\x73\x3e\x32\x35\x30\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x4d\x61\x78\x45\x6c\x65\x6d\x65\x6e\x74\x73\x3e\x3c\x2f\x77\x73\x65\x6e\x3a\x45\x6e\x75\x6d\x65\x72\x61\x74\x65\x3e\x3c\x2f\x73\x3a\x42\x6f\x64\x79\x3e\x3c\x2f\x73\x3a\x45\x6e\x76\x65\x6c\x6f\x70\x65\x3e\x0a"
-
-func wsman_infoBytes() ([]byte, error) {
-	return bindataRead(
-		_wsman_info,
-		"wsman_info",
-	)
-}
-
-func wsman_info() (*asset, error) {
-	bytes, err := wsman_infoBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "wsman_info", size: 1052, mode: os.FileMode(420), modTime: time.Unix(1491066347, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _wsman_info_step2 = "\x3c\x3f\x78\x6d\x6c\x20\x76\x65\x72\x73\x69\x6f\x6e\x3d\x22\x31\x2e\x30\x22\x20\x65\x6e\x63\x6f\x64\x69\x6e\x67\x3d\x22\x55\x54\x46\x2d\x38\x22\x3f\x3e\x0a\x3c\x73\x3a\x45\x6e\x76\x65\x6c\x6f\x70\x65\x20\x78\x6d\x6c\x6e\x73\x3a\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x33\x2f\x30\x35\x2f\x73\x6f\x61\x70\x2d\x65\x6e\x76\x65\x6c\x6f\x70\x65\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x61\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x6d\x61\x6e\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x65\x6e\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x39\x2f\x65\x6e\x75\x6d\x65\x72\x61\x74\x69\x6f\x6e\x22\x3e\x3c\x73\x3a\x48\x65\x61\x64\x65\x72\x3e\x3c\x77\x73\x61\x3a\x41\x63\x74\x69\x6f\x6e\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x39\x2f\x65\x6e\x75\x6d\x65\x72\x61\x74\x69\x6f\x6e\x2f\x50\x75\x6c\x6c\x3c\x2f\x77\x73\x61\x3a\x41\x63\x74\x69\x6f\x6e\x3e\x3c\x77\x73\x61\x3a\x54\x6f\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x31\x39\x32\x2e\x31\x36\x38\x2e\x30\x2e\x31\x30\x30\x3a\x31\x36\x39\x39\x32\x2f\x77\x73\x6d\x61\x6e\x3c\x2f\x77\x73\x61\x3a\x54\x6f\x3e\x3c\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x41\x73\x73\x6f\x63\x69\x61\x74\x65\x64\x50\x6f\x77\x65\x72\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x3c\x77\x73\x61\x3a\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x75\x75\x69\x64\x3a\x39\x38\x65\x61\x38\x61\x30\x66\x2d\x62\x39\x38\x39\x2d\x34\x38\x64\x31\x2d\x38\x35\x66\x38\x2d\x34\x65\x62\x34\x38\x38\x35\x38\x62\x66\x64\x36\x3c\x2f\x77\x73\x61\x3a\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x3e\x3c\x77\x73\x61\x3a\x52\x65\x70\x6c\x79\x54\x6f\x3e\x3c\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x3c\x2f\x77\x73\x61\x3a\x52\x65\x70\x6c\x79\x54\x6f\x3e\x3c\x2f\x73\x3a\x48\x65\x61\x64\x65\x72\x3e\x3c\x73\x3a\x42\x6f\x64\x79\x3e\x3c\x77\x73\x65\x6e\x3a\x50\x75\x6c\x6c\x3e\x3c\x77\x73\x65\x6e\x3a\x45\x6e\x75\x6d\x65\x72\x61\x74\x69\x6f\x6e\x43\x6f\x6e\x74\x65\x78\x74\x3e\x25\x73\x3c\x2f\x77\x73\x65\x6e\x3a\x45\x6e\x75\x6d\x65\x72\x61\x74\x69\x6f\x6e\x43\x6f\x6e\x74\x65\x78\x74\x3e\x3c\x77\x73\x65\x6e\x3a\x4d\x61\x78\x45\x6c\x65\x6d\x65\x6e\x74\x73\x3e\x32\x35\x30\x3c\x2f\x77\x73\x65\x6e\x3a\x4d\x61\x78\x45\x6c\x65\x6d\x65\x6e\x74\x73\x3e\x3c\x2f\x77\x73\x65\x6e\x3a\x50\x75\x6c\x6c\x3e\x3c\x2f\x73\x3a\x42\x6f\x64\x79\x3e\x3c\x2f\x73\x3a\x45\x6e\x76\x65\x6c\x6f\x70\x65\x3e\x0a"
-
-func wsman_info_step2Bytes() ([]byte, error) {
-	return bindataRead(
-		_wsman_info_step2,
-		"wsman_info_step2",
-	)
-}
-
-func wsman_info_step2() (*asset, error) {
-	bytes, err := wsman_info_step2Bytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "wsman_info_step2", size: 992, mode: os.FileMode(420), modTime: time.Unix(1491066347, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _wsman_ping_disable = "\x3c\x3f\x78\x6d\x6c\x20\x76\x65\x72\x73\x69\x6f\x6e\x3d\x22\x31\x2e\x30\x22\x20\x65\x6e\x63\x6f\x64\x69\x6e\x67\x3d\x22\x75\x74\x66\x2d\x38\x22\x3f\x3e\x3c\x45\x6e\x76\x65\x6c\x6f\x70\x65\x20\x78\x6d\x6c\x6e\x73\x3a\x78\x73\x69\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x31\x2f\x58\x4d\x4c\x53\x63\x68\x65\x6d\x61\x2d\x69\x6e\x73\x74\x61\x6e\x63\x65\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x78\x73\x64\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x31\x2f\x58\x4d\x4c\x53\x63\x68\x65\x6d\x61\x22\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x33\x2f\x30\x35\x2f\x73\x6f\x61\x70\x2d\x65\x6e\x76\x65\x6c\x6f\x70\x65\x22\x3e\x3c\x48\x65\x61\x64\x65\x72\x3e\x3c\x41\x63\x74\x69\x6f\x6e\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x39\x2f\x74\x72\x61\x6e\x73\x66\x65\x72\x2f\x50\x75\x74\x3c\x2f\x41\x63\x74\x69\x6f\x6e\x3e\x3c\x54\x6f\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x31\x39\x32\x2e\x31\x36\x38\x2e\x30\x2e\x31\x30\x30\x3a\x31\x36\x39\x39\x32\x2f\x77\x73\x6d\x61\x6e\x3c\x2f\x54\x6f\x3e\x3c\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x69\x6e\x74\x65\x6c\x2e\x63\x6f\x6d\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x61\x6d\x74\x2d\x73\x63\x68\x65\x6d\x61\x2f\x31\x2f\x41\x4d\x54\x5f\x47\x65\x6e\x65\x72\x61\x6c\x53\x65\x74\x74\x69\x6e\x67\x73\x3c\x2f\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x3c\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x3e\x33\x36\x37\x38\x39\x31\x38\x66\x2d\x37\x31\x66\x64\x2d\x34\x61\x34\x30\x2d\x62\x62\x31\x37\x2d\x38\x31\x62\x66\x66\x37\x34\x37\x31\x30\x30\x63\x3c\x2f\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x3e\x3c\x52\x65\x70\x6c\x79\x54\x6f\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x3e\x3c\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x41\x64\x64\x72\x65\x73\x73\x3e\x3c\x2f\x52\x65\x70\x6c\x79\x54\x6f\x3e\x3c\x4f\x70\x65\x72\x61\x74\x69\x6f\x6e\x54\x69\x6d\x65\x6f\x75\x74\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x3e\x50\x54\x36\x30\x2e\x30\x30\x30\x53\x3c\x2f\x4f\x70\x65\x72\x61\x74\x69\x6f\x6e\x54\x69\x6d\x65\x6f\x75\x74\x3e\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x6d\x61\x6e\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x3e\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x49\x6e\x73\x74\x61\x6e\x63\x65\x49\x44\x22\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x3a\x20\x47\x65\x6e\x65\x72\x61\x6c\x20\x53\x65\x74\x74\x69\x6e\x67\x73\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x3c\x2f\x48\x65\x61\x64\x65\x72\x3e\x3c\x42\x6f\x64\x79\x3e\x3c\x67\x3a\x41\x4d\x54\x5f\x47\x65\x6e\x65\x72\x61\x6c\x53\x65\x74\x74\x69\x6e\x67\x73\x20\x78\x6d\x6c\x6e\x73\x3a\x67\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x69\x6e\x74\x65\x6c\x2e\x63\x6f\x6d\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x61\x6d\x74\x2d\x73\x63\x68\x65\x6d\x61\x2f\x31\x2f\x41\x4d\x54\x5f\x47\x65\x6e\x65\x72\x61\x6c\x53\x65\x74\x74\x69\x6e\x67\x73\x22\x3e\x0a\x3c\x67\x3a\x49\x6e\x73\x74\x61\x6e\x63\x65\x49\x44\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x3a\x20\x47\x65\x6e\x65\x72\x61\x6c\x20\x53\x65\x74\x74\x69\x6e\x67\x73\x3c\x2f\x67\x3a\x49\x6e\x73\x74\x61\x6e\x63\x65\x49\x44\x3e\x0a\x3c\x67\x3a\x50\x69\x6e\x67\x52\x65\x73\x70\x6f\x6e\x73\x65\x45\x6e\x61\x62\x6c\x65\x64\x3e\x66\x61\x6c\x73\x65\x3c\x2f\x67\x3a\x50\x69\x6e\x67\x52\x65\x73\x70\x6f\x6e\x73\x65\x45\x6e\x61\x62\x6c\x65\x64\x3e\x0a\x3c\x2f\x67\x3a\x41\x4d\x54\x5f\x47\x65\x6e\x65\x72\x61\x6c\x53\x65\x74\x74\x69\x6e\x67\x73\x3e\x0a\x3c\x2f\x42\x6f\x64\x79\x3e\x3c\x2f\x45\x6e\x76\x65\x6c\x6f\x70\x65\x3e\x0a"
-
-func wsman_ping_disableBytes() ([]byte, error) {
-	return bindataRead(
-		_wsman_ping_disable,
-		"wsman_ping_disable",
-	)
-}
-
-func wsman_ping_disable() (*asset, error) {
-	bytes, err := wsman_ping_disableBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "wsman_ping_disable", size: 1394, mode: os.FileMode(420), modTime: time.Unix(1491066347, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _wsman_ping_enable = "\x3c\x3f\x78\x6d\x6c\x20\x76\x65\x72\x73\x69\x6f\x6e\x3d\x22\x31\x2e\x30\x22\x20\x65\x6e\x63\x6f\x64\x69\x6e\x67\x3d\x22\x75\x74\x66\x2d\x38\x22\x3f\x3e\x3c\x45\x6e\x76\x65\x6c\x6f\x70\x65\x20\x78\x6d\x6c\x6e\x73\x3a\x78\x73\x69\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x31\x2f\x58\x4d\x4c\x53\x63\x68\x65\x6d\x61\x2d\x69\x6e\x73\x74\x61\x6e\x63\x65\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x78\x73\x64\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x31\x2f\x58\x4d\x4c\x53\x63\x68\x65\x6d\x61\x22\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x33\x2f\x30\x35\x2f\x73\x6f\x61\x70\x2d\x65\x6e\x76\x65\x6c\x6f\x70\x65\x22\x3e\x3c\x48\x65\x61\x64\x65\x72\x3e\x3c\x41\x63\x74\x69\x6f\x6e\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x39\x2f\x74\x72\x61\x6e\x73\x66\x65\x72\x2f\x50\x75\x74\x3c\x2f\x41\x63\x74\x69\x6f\x6e\x3e\x3c\x54\x6f\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x31\x39\x32\x2e\x31\x36\x38\x2e\x30\x2e\x31\x30\x30\x3a\x31\x36\x39\x39\x32\x2f\x77\x73\x6d\x61\x6e\x3c\x2f\x54\x6f\x3e\x3c\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x69\x6e\x74\x65\x6c\x2e\x63\x6f\x6d\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x61\x6d\x74\x2d\x73\x63\x68\x65\x6d\x61\x2f\x31\x2f\x41\x4d\x54\x5f\x47\x65\x6e\x65\x72\x61\x6c\x53\x65\x74\x74\x69\x6e\x67\x73\x3c\x2f\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x3c\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x3e\x33\x36\x37\x38\x39\x31\x38\x66\x2d\x37\x31\x66\x64\x2d\x34\x61\x34\x30\x2d\x62\x62\x31\x37\x2d\x38\x31\x62\x66\x66\x37\x34\x37\x31\x30\x30\x63\x3c\x2f\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x3e\x3c\x52\x65\x70\x6c\x79\x54\x6f\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x3e\x3c\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x41\x64\x64\x72\x65\x73\x73\x3e\x3c\x2f\x52\x65\x70\x6c\x79\x54\x6f\x3e\x3c\x4f\x70\x65\x72\x61\x74\x69\x6f\x6e\x54\x69\x6d\x65\x6f\x75\x74\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x3e\x50\x54\x36\x30\x2e\x30\x30\x30\x53\x3c\x2f\x4f\x70\x65\x72\x61\x74\x69\x6f\x6e\x54\x69\x6d\x65\x6f\x75\x74\x3e\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x6d\x61\x6e\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x3e\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x49\x6e\x73\x74\x61\x6e\x63\x65\x49\x44\x22\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x3a\x20\x47\x65\x6e\x65\x72\x61\x6c\x20\x53\x65\x74\x74\x69\x6e\x67\x73\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x3c\x2f\x48\x65\x61\x64\x65\x72\x3e\x3c\x42\x6f\x64\x79\x3e\x3c\x67\x3a\x41\x4d\x54\x5f\x47\x65\x6e\x65\x72\x61\x6c\x53\x65\x74\x74\x69\x6e\x67\x73\x20\x78\x6d\x6c\x6e\x73\x3a\x67\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x69\x6e\x74\x65\x6c\x2e\x63\x6f\x6d\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x61\x6d\x74\x2d\x73\x63\x68\x65\x6d\x61\x2f\x31\x2f\x41\x4d\x54\x5f\x47\x65\x6e\x65\x72\x61\x6c\x53\x65\x74\x74\x69\x6e\x67\x73\x22\x3e\x0a\x3c\x67\x3a\x49\x6e\x73\x74\x61\x6e\x63\x65\x49\x44\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x3a\x20\x47\x65\x6e\x65\x72\x61\x6c\x20\x53\x65\x74\x74\x69\x6e\x67\x73\x3c\x2f\x67\x3a\x49\x6e\x73\x74\x61\x6e\x63\x65\x49\x44\x3e\x0a\x3c\x67\x3a\x50\x69\x6e\x67\x52\x65\x73\x70\x6f\x6e\x73\x65\x45\x6e\x61\x62\x6c\x65\x64\x3e\x74\x72\x75\x65\x3c\x2f\x67\x3a\x50\x69\x6e\x67\x52\x65\x73\x70\x6f\x6e\x73\x65\x45\x6e\x61\x62\x6c\x65\x64\x3e\x0a\x3c\x2f\x67\x3a\x41\x4d\x54\x5f\x47\x65\x6e\x65\x72\x61\x6c\x53\x65\x74\x74\x69\x6e\x67\x73\x3e\x0a\x3c\x2f\x42\x6f\x64\x79\x3e\x3c\x2f\x45\x6e\x76\x65\x6c\x6f\x70\x65\x3e\x0a"
-
-func wsman_ping_enableBytes() ([]byte, error) {
-	return bindataRead(
-		_wsman_ping_enable,
-		"wsman_ping_enable",
-	)
-}
-
-func wsman_ping_enable() (*asset, error) {
-	bytes, err := wsman_ping_enableBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "wsman_ping_enable", size: 1393, mode: os.FileMode(420), modTime: time.Unix(1491066347, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _wsman_pxeboot = "\x3c\x3f\x78\x6d\x6c\x20\x76\x65\x72\x73\x69\x6f\x6e\x3d\x22\x31\x2e\x30\x22\x20\x65\x6e\x63\x6f\x64\x69\x6e\x67\x3d\x22\x55\x54\x46\x2d\x38\x22\x3f\x3e\x0a\x3c\x73\x3a\x45\x6e\x76\x65\x6c\x6f\x70\x65\x20\x78\x6d\x6c\x6e\x73\x3a\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x33\x2f\x30\x35\x2f\x73\x6f\x61\x70\x2d\x65\x6e\x76\x65\x6c\x6f\x70\x65\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x61\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x6d\x61\x6e\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x6e\x31\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x42\x6f\x6f\x74\x43\x6f\x6e\x66\x69\x67\x53\x65\x74\x74\x69\x6e\x67\x22\x3e\x0a\x3c\x73\x3a\x48\x65\x61\x64\x65\x72\x3e\x0a\x3c\x77\x73\x61\x3a\x41\x63\x74\x69\x6f\x6e\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x42\x6f\x6f\x74\x43\x6f\x6e\x66\x69\x67\x53\x65\x74\x74\x69\x6e\x67\x2f\x43\x68\x61\x6e\x67\x65\x42\x6f\x6f\x74\x4f\x72\x64\x65\x72\x3c\x2f\x77\x73\x61\x3a\x41\x63\x74\x69\x6f\x6e\x3e\x0a\x3c\x77\x73\x61\x3a\x54\x6f\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x31\x39\x32\x2e\x31\x36\x38\x2e\x30\x2e\x31\x30\x30\x3a\x31\x36\x39\x39\x32\x2f\x77\x73\x6d\x61\x6e\x3c\x2f\x77\x73\x61\x3a\x54\x6f\x3e\x0a\x3c\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x42\x6f\x6f\x74\x43\x6f\x6e\x66\x69\x67\x53\x65\x74\x74\x69\x6e\x67\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x0a\x3c\x77\x73\x61\x3a\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x75\x75\x69\x64\x3a\x63\x34\x30\x38\x61\x31\x38\x39\x2d\x63\x39\x38\x63\x2d\x34\x35\x34\x37\x2d\x61\x32\x63\x30\x2d\x64\x65\x35\x36\x34\x31\x62\x65\x37\x35\x32\x31\x3c\x2f\x77\x73\x61\x3a\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x3e\x0a\x3c\x77\x73\x61\x3a\x52\x65\x70\x6c\x79\x54\x6f\x3e\x0a\x20\x20\x3c\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x0a\x3c\x2f\x77\x73\x61\x3a\x52\x65\x70\x6c\x79\x54\x6f\x3e\x0a\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x49\x6e\x73\x74\x61\x6e\x63\x65\x49\x44\x22\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x3a\x20\x42\x6f\x6f\x74\x20\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x20\x30\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x3c\x2f\x73\x3a\x48\x65\x61\x64\x65\x72\x3e\x0a\x3c\x73\x3a\x42\x6f\x64\x79\x3e\x0a\x20\x20\x3c\x6e\x31\x3a\x43\x68\x61\x6e\x67\x65\x42\x6f\x6f\x74\x4f\x72\x64\x65\x72\x5f\x49\x4e\x50\x55\x54\x3e\x0a\x20\x20\x20\x20\x3c\x6e\x31\x3a\x53\x6f\x75\x72\x63\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x61\x3a\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x50\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x42\x6f\x6f\x74\x53\x6f\x75\x72\x63\x65\x53\x65\x74\x74\x69\x6e\x67\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x77\x73\x6d\x61\x6e\x3a\x4e\x61\x6d\x65\x3d\x22\x49\x6e\x73\x74\x61\x6e\x63\x65\x49\x44\x22\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x3a\x20\x46\x6f\x72\x63\x65\x20\x50\x58\x45\x20\x42\x6f\x6f\x74\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x77\x73\x61\x3a\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x50\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x6e\x31\x3a\x53\x6f\x75\x72\x63\x65\x3e\x0a\x20\x20\x3c\x2f\x6e\x31\x3a\x43\x68\x61\x6e\x67\x65\x42\x6f\x6f\x74\x4f\x72\x64\x65\x72\x5f\x49\x4e\x50\x55\x54\x3e\x0a\x3c\x2f\x73\x3a\x42\x6f\x64\x79\x3e\x3c\x2f\x73\x3a\x45\x6e\x76\x65\x6c\x6f\x70\x65\x3e"
-
-func wsman_pxebootBytes() ([]byte, error) {
-	return bindataRead(
-		_wsman_pxeboot,
-		"wsman_pxeboot",
-	)
-}
-
-func wsman_pxeboot() (*asset, error) {
-	bytes, err := wsman_pxebootBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "wsman_pxeboot", size: 1581, mode: os.FileMode(420), modTime: time.Unix(1491066347, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _wsman_reset = "\x3c\x3f\x78\x6d\x6c\x20\x76\x65\x72\x73\x69\x6f\x6e\x3d\x22\x31\x2e\x30\x22\x20\x65\x6e\x63\x6f\x64\x69\x6e\x67\x3d\x22\x55\x54\x46\x2d\x38\x22\x3f\x3e\x0a\x3c\x73\x3a\x45\x6e\x76\x65\x6c\x6f\x70\x65\x20\x78\x6d\x6c\x6e\x73\x3a\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x33\x2f\x30\x35\x2f\x73\x6f\x61\x70\x2d\x65\x6e\x76\x65\x6c\x6f\x70\x65\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x61\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x6d\x61\x6e\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x6e\x31\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x50\x6f\x77\x65\x72\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x53\x65\x72\x76\x69\x63\x65\x22\x3e\x0a\x3c\x73\x3a\x48\x65\x61\x64\x65\x72\x3e\x0a\x20\x20\x3c\x77\x73\x61\x3a\x41\x63\x74\x69\x6f\x6e\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x50\x6f\x77\x65\x72\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x53\x65\x72\x76\x69\x63\x65\x2f\x52\x65\x71\x75\x65\x73\x74\x50\x6f\x77\x65\x72\x53\x74\x61\x74\x65\x43\x68\x61\x6e\x67\x65\x3c\x2f\x77\x73\x61\x3a\x41\x63\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x3c\x77\x73\x61\x3a\x54\x6f\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x31\x39\x32\x2e\x31\x36\x38\x2e\x30\x2e\x31\x30\x30\x3a\x31\x36\x39\x39\x32\x2f\x77\x73\x6d\x61\x6e\x3c\x2f\x77\x73\x61\x3a\x54\x6f\x3e\x0a\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x50\x6f\x77\x65\x72\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x0a\x20\x20\x3c\x77\x73\x61\x3a\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x75\x75\x69\x64\x3a\x37\x30\x32\x32\x61\x64\x63\x35\x2d\x64\x61\x37\x36\x2d\x34\x63\x39\x62\x2d\x38\x61\x64\x37\x2d\x39\x37\x34\x33\x62\x37\x62\x32\x30\x35\x66\x32\x3c\x2f\x77\x73\x61\x3a\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x3e\x0a\x20\x20\x3c\x77\x73\x61\x3a\x52\x65\x70\x6c\x79\x54\x6f\x3e\x3c\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x3c\x2f\x77\x73\x61\x3a\x52\x65\x70\x6c\x79\x54\x6f\x3e\x0a\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x4e\x61\x6d\x65\x22\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x20\x50\x6f\x77\x65\x72\x20\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x20\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x53\x79\x73\x74\x65\x6d\x4e\x61\x6d\x65\x22\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x22\x3e\x43\x49\x4d\x5f\x50\x6f\x77\x65\x72\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x53\x79\x73\x74\x65\x6d\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x22\x3e\x43\x49\x4d\x5f\x43\x6f\x6d\x70\x75\x74\x65\x72\x53\x79\x73\x74\x65\x6d\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x3c\x2f\x73\x3a\x48\x65\x61\x64\x65\x72\x3e\x0a\x3c\x73\x3a\x42\x6f\x64\x79\x3e\x0a\x20\x20\x3c\x6e\x31\x3a\x52\x65\x71\x75\x65\x73\x74\x50\x6f\x77\x65\x72\x53\x74\x61\x74\x65\x43\x68\x61\x6e\x67\x65\x5f\x49\x4e\x50\x55\x54\x3e\x0a\x20\x20\x20\x20\x3c\x6e\x31\x3a\x50\x6f\x77\x65\x72\x53\x74\x61\x74\x65\x3e\x31\x30\x3c\x2f\x6e\x31\x3a\x50\x6f\x77\x65\x72\x53\x74\x61\x74\x65\x3e\x0a\x20\x20\x20\x20\x3c\x6e\x31\x3a\x4d\x61\x6e\x61\x67\x65\x64\x45\x6c\x65\x6d\x65\x6e\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x61\x3a\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x50\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x43\x6f\x6d\x70\x75\x74\x65\x72\x53\x79\x73\x74\x65\x6d\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x4e\x61\x6d\x65\x22\x3e\x4d\x61\x6e\x61\x67\x65\x64\x53\x79\x73\x74\x65\x6d\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x22\x3e\x43\x49\x4d\x5f\x43\x6f\x6d\x70\x75\x74\x65\x72\x53\x79\x73\x74\x65\x6d\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x77\x73\x61\x3a\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x50\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x6e\x31\x3a\x4d\x61\x6e\x61\x67\x65\x64\x45\x6c\x65\x6d\x65\x6e\x74\x3e\x0a\x20\x20\x3c\x2f\x6e\x31\x3a\x52\x65\x71\x75\x65\x73\x74\x50\x6f\x77\x65\x72\x53\x74\x61\x74\x65\x43\x68\x61\x6e\x67\x65\x5f\x49\x4e\x50\x55\x54\x3e\x0a\x3c\x2f\x73\x3a\x42\x6f\x64\x79\x3e\x0a\x3c\x2f\x73\x3a\x45\x6e\x76\x65\x6c\x6f\x70\x65\x3e\x0a"
-
-func wsman_resetBytes() ([]byte, error) {
-	return bindataRead(
-		_wsman_reset,
-		"wsman_reset",
-	)
-}
-
-func wsman_reset() (*asset, error) {
-	bytes, err := wsman_resetBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "wsman_reset", size: 1986, mode: os.FileMode(420), modTime: time.Unix(1491066347, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _wsman_reset_graceful = "\x3c\x3f\x78\x6d\x6c\x20\x76\x65\x72\x73\x69\x6f\x6e\x3d\x22\x31\x2e\x30\x22\x20\x65\x6e\x63\x6f\x64\x69\x6e\x67\x3d\x22\x55\x54\x46\x2d\x38\x22\x3f\x3e\x0a\x3c\x73\x3a\x45\x6e\x76\x65\x6c\x6f\x70\x65\x20\x78\x6d\x6c\x6e\x73\x3a\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x33\x2f\x30\x35\x2f\x73\x6f\x61\x70\x2d\x65\x6e\x76\x65\x6c\x6f\x70\x65\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x61\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x6d\x61\x6e\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x6e\x31\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x50\x6f\x77\x65\x72\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x53\x65\x72\x76\x69\x63\x65\x22\x3e\x0a\x3c\x73\x3a\x48\x65\x61\x64\x65\x72\x3e\x0a\x20\x20\x3c\x77\x73\x61\x3a\x41\x63\x74\x69\x6f\x6e\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x50\x6f\x77\x65\x72\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x53\x65\x72\x76\x69\x63\x65\x2f\x52\x65\x71\x75\x65\x73\x74\x50\x6f\x77\x65\x72\x53\x74\x61\x74\x65\x43\x68\x61\x6e\x67\x65\x3c\x2f\x77\x73\x61\x3a\x41\x63\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x3c\x77\x73\x61\x3a\x54\x6f\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x31\x39\x32\x2e\x31\x36\x38\x2e\x30\x2e\x31\x30\x30\x3a\x31\x36\x39\x39\x32\x2f\x77\x73\x6d\x61\x6e\x3c\x2f\x77\x73\x61\x3a\x54\x6f\x3e\x0a\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x50\x6f\x77\x65\x72\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x0a\x20\x20\x3c\x77\x73\x61\x3a\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x75\x75\x69\x64\x3a\x37\x30\x32\x32\x61\x64\x63\x35\x2d\x64\x61\x37\x36\x2d\x34\x63\x39\x62\x2d\x38\x61\x64\x37\x2d\x39\x37\x34\x33\x62\x37\x62\x32\x30\x35\x66\x32\x3c\x2f\x77\x73\x61\x3a\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x3e\x0a\x20\x20\x3c\x77\x73\x61\x3a\x52\x65\x70\x6c\x79\x54\x6f\x3e\x3c\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x3c\x2f\x77\x73\x61\x3a\x52\x65\x70\x6c\x79\x54\x6f\x3e\x0a\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x4e\x61\x6d\x65\x22\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x20\x50\x6f\x77\x65\x72\x20\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x20\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x53\x79\x73\x74\x65\x6d\x4e\x61\x6d\x65\x22\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x22\x3e\x43\x49\x4d\x5f\x50\x6f\x77\x65\x72\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x53\x79\x73\x74\x65\x6d\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x22\x3e\x43\x49\x4d\x5f\x43\x6f\x6d\x70\x75\x74\x65\x72\x53\x79\x73\x74\x65\x6d\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x3c\x2f\x73\x3a\x48\x65\x61\x64\x65\x72\x3e\x0a\x3c\x73\x3a\x42\x6f\x64\x79\x3e\x0a\x20\x20\x3c\x6e\x31\x3a\x52\x65\x71\x75\x65\x73\x74\x50\x6f\x77\x65\x72\x53\x74\x61\x74\x65\x43\x68\x61\x6e\x67\x65\x5f\x49\x4e\x50\x55\x54\x3e\x0a\x20\x20\x20\x20\x3c\x6e\x31\x3a\x50\x6f\x77\x65\x72\x53\x74\x61\x74\x65\x3e\x31\x34\x3c\x2f\x6e\x31\x3a\x50\x6f\x77\x65\x72\x53\x74\x61\x74\x65\x3e\x0a\x20\x20\x20\x20\x3c\x6e\x31\x3a\x4d\x61\x6e\x61\x67\x65\x64\x45\x6c\x65\x6d\x65\x6e\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x61\x3a\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x50\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x43\x6f\x6d\x70\x75\x74\x65\x72\x53\x79\x73\x74\x65\x6d\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x4e\x61\x6d\x65\x22\x3e\x4d\x61\x6e\x61\x67\x65\x64\x53\x79\x73\x74\x65\x6d\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x22\x3e\x43\x49\x4d\x5f\x43\x6f\x6d\x70\x75\x74\x65\x72\x53\x79\x73\x74\x65\x6d\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x77\x73\x61\x3a\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x50\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x6e\x31\x3a\x4d\x61\x6e\x61\x67\x65\x64\x45\x6c\x65\x6d\x65\x6e\x74\x3e\x0a\x20\x20\x3c\x2f\x6e\x31\x3a\x52\x65\x71\x75\x65\x73\x74\x50\x6f\x77\x65\x72\x53\x74\x61\x74\x65\x43\x68\x61\x6e\x67\x65\x5f\x49\x4e\x50\x55\x54\x3e\x0a\x3c\x2f\x73\x3a\x42\x6f\x64\x79\x3e\x0a\x3c\x2f\x73\x3a\x45\x6e\x76\x65\x6c\x6f\x70\x65\x3e\x0a"
-
-func wsman_reset_gracefulBytes() ([]byte, error) {
-	return bindataRead(
-		_wsman_reset_graceful,
-		"wsman_reset_graceful",
-	)
-}
-
-func wsman_reset_graceful() (*asset, error) {
-	bytes, err := wsman_reset_gracefulBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "wsman_reset_graceful", size: 1986, mode: os.FileMode(420), modTime: time.Unix(1491066347, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _wsman_shutdown_graceful = "\x3c\x3f\x78\x6d\x6c\x20\x76\x65\x72\x73\x69\x6f\x6e\x3d\x22\x31\x2e\x30\x22\x20\x65\x6e\x63\x6f\x64\x69\x6e\x67\x3d\x22\x55\x54\x46\x2d\x38\x22\x3f\x3e\x0a\x3c\x73\x3a\x45\x6e\x76\x65\x6c\x6f\x70\x65\x20\x78\x6d\x6c\x6e\x73\x3a\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x33\x2f\x30\x35\x2f\x73\x6f\x61\x70\x2d\x65\x6e\x76\x65\x6c\x6f\x70\x65\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x61\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x6d\x61\x6e\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x6e\x31\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x50\x6f\x77\x65\x72\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x53\x65\x72\x76\x69\x63\x65\x22\x3e\x0a\x3c\x73\x3a\x48\x65\x61\x64\x65\x72\x3e\x0a\x20\x20\x3c\x77\x73\x61\x3a\x41\x63\x74\x69\x6f\x6e\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x50\x6f\x77\x65\x72\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x53\x65\x72\x76\x69\x63\x65\x2f\x52\x65\x71\x75\x65\x73\x74\x50\x6f\x77\x65\x72\x53\x74\x61\x74\x65\x43\x68\x61\x6e\x67\x65\x3c\x2f\x77\x73\x61\x3a\x41\x63\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x3c\x77\x73\x61\x3a\x54\x6f\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x31\x39\x32\x2e\x31\x36\x38\x2e\x30\x2e\x31\x30\x30\x3a\x31\x36\x39\x39\x32\x2f\x77\x73\x6d\x61\x6e\x3c\x2f\x77\x73\x61\x3a\x54\x6f\x3e\x0a\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x50\x6f\x77\x65\x72\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x0a\x20\x20\x3c\x77\x73\x61\x3a\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x75\x75\x69\x64\x3a\x37\x30\x32\x32\x61\x64\x63\x35\x2d\x64\x61\x37\x36\x2d\x34\x63\x39\x62\x2d\x38\x61\x64\x37\x2d\x39\x37\x34\x33\x62\x37\x62\x32\x30\x35\x66\x32\x3c\x2f\x77\x73\x61\x3a\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x3e\x0a\x20\x20\x3c\x77\x73\x61\x3a\x52\x65\x70\x6c\x79\x54\x6f\x3e\x3c\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x3c\x2f\x77\x73\x61\x3a\x52\x65\x70\x6c\x79\x54\x6f\x3e\x0a\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x4e\x61\x6d\x65\x22\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x20\x50\x6f\x77\x65\x72\x20\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x20\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x53\x79\x73\x74\x65\x6d\x4e\x61\x6d\x65\x22\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x22\x3e\x43\x49\x4d\x5f\x50\x6f\x77\x65\x72\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x53\x79\x73\x74\x65\x6d\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x22\x3e\x43\x49\x4d\x5f\x43\x6f\x6d\x70\x75\x74\x65\x72\x53\x79\x73\x74\x65\x6d\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x3c\x2f\x73\x3a\x48\x65\x61\x64\x65\x72\x3e\x0a\x3c\x73\x3a\x42\x6f\x64\x79\x3e\x0a\x20\x20\x3c\x6e\x31\x3a\x52\x65\x71\x75\x65\x73\x74\x50\x6f\x77\x65\x72\x53\x74\x61\x74\x65\x43\x68\x61\x6e\x67\x65\x5f\x49\x4e\x50\x55\x54\x3e\x0a\x20\x20\x20\x20\x3c\x6e\x31\x3a\x50\x6f\x77\x65\x72\x53\x74\x61\x74\x65\x3e\x31\x32\x3c\x2f\x6e\x31\x3a\x50\x6f\x77\x65\x72\x53\x74\x61\x74\x65\x3e\x0a\x20\x20\x20\x20\x3c\x6e\x31\x3a\x4d\x61\x6e\x61\x67\x65\x64\x45\x6c\x65\x6d\x65\x6e\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x61\x3a\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x50\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x43\x6f\x6d\x70\x75\x74\x65\x72\x53\x79\x73\x74\x65\x6d\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x4e\x61\x6d\x65\x22\x3e\x4d\x61\x6e\x61\x67\x65\x64\x53\x79\x73\x74\x65\x6d\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x22\x3e\x43\x49\x4d\x5f\x43\x6f\x6d\x70\x75\x74\x65\x72\x53\x79\x73\x74\x65\x6d\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x77\x73\x61\x3a\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x50\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x6e\x31\x3a\x4d\x61\x6e\x61\x67\x65\x64\x45\x6c\x65\x6d\x65\x6e\x74\x3e\x0a\x20\x20\x3c\x2f\x6e\x31\x3a\x52\x65\x71\x75\x65\x73\x74\x50\x6f\x77\x65\x72\x53\x74\x61\x74\x65\x43\x68\x61\x6e\x67\x65\x5f\x49\x4e\x50\x55\x54\x3e\x0a\x3c\x2f\x73\x3a\x42\x6f\x64\x79\x3e\x0a\x3c\x2f\x73\x3a\x45\x6e\x76\x65\x6c\x6f\x70\x65\x3e\x0a"
-
-func wsman_shutdown_gracefulBytes() ([]byte, error) {
-	return bindataRead(
-		_wsman_shutdown_graceful,
-		"wsman_shutdown_graceful",
-	)
-}
-
-func wsman_shutdown_graceful() (*asset, error) {
-	bytes, err := wsman_shutdown_gracefulBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "wsman_shutdown_graceful", size: 1986, mode: os.FileMode(420), modTime: time.Unix(1491066347, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _wsman_solredir_disable = "\x3c\x3f\x78\x6d\x6c\x20\x76\x65\x72\x73\x69\x6f\x6e\x3d\x22\x31\x2e\x30\x22\x20\x65\x6e\x63\x6f\x64\x69\x6e\x67\x3d\x22\x75\x74\x66\x2d\x38\x22\x3f\x3e\x3c\x45\x6e\x76\x65\x6c\x6f\x70\x65\x20\x78\x6d\x6c\x6e\x73\x3a\x78\x73\x69\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x31\x2f\x58\x4d\x4c\x53\x63\x68\x65\x6d\x61\x2d\x69\x6e\x73\x74\x61\x6e\x63\x65\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x78\x73\x64\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x31\x2f\x58\x4d\x4c\x53\x63\x68\x65\x6d\x61\x22\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x33\x2f\x30\x35\x2f\x73\x6f\x61\x70\x2d\x65\x6e\x76\x65\x6c\x6f\x70\x65\x22\x3e\x3c\x48\x65\x61\x64\x65\x72\x3e\x3c\x41\x63\x74\x69\x6f\x6e\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x39\x2f\x74\x72\x61\x6e\x73\x66\x65\x72\x2f\x50\x75\x74\x3c\x2f\x41\x63\x74\x69\x6f\x6e\x3e\x3c\x54\x6f\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x31\x39\x32\x2e\x31\x36\x38\x2e\x30\x2e\x31\x30\x30\x3a\x31\x36\x39\x39\x32\x2f\x77\x73\x6d\x61\x6e\x3c\x2f\x54\x6f\x3e\x3c\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x69\x6e\x74\x65\x6c\x2e\x63\x6f\x6d\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x61\x6d\x74\x2d\x73\x63\x68\x65\x6d\x61\x2f\x31\x2f\x41\x4d\x54\x5f\x52\x65\x64\x69\x72\x65\x63\x74\x69\x6f\x6e\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x3c\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x3e\x61\x66\x33\x38\x32\x64\x34\x32\x2d\x64\x63\x65\x62\x2d\x34\x32\x35\x66\x2d\x61\x36\x62\x64\x2d\x34\x37\x37\x32\x30\x38\x64\x34\x62\x61\x37\x38\x3c\x2f\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x3e\x3c\x52\x65\x70\x6c\x79\x54\x6f\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x3e\x3c\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x41\x64\x64\x72\x65\x73\x73\x3e\x3c\x2f\x52\x65\x70\x6c\x79\x54\x6f\x3e\x3c\x4f\x70\x65\x72\x61\x74\x69\x6f\x6e\x54\x69\x6d\x65\x6f\x75\x74\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x3e\x50\x54\x36\x30\x2e\x30\x30\x30\x53\x3c\x2f\x4f\x70\x65\x72\x61\x74\x69\x6f\x6e\x54\x69\x6d\x65\x6f\x75\x74\x3e\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x6d\x61\x6e\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x3e\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x22\x3e\x41\x4d\x54\x5f\x52\x65\x64\x69\x72\x65\x63\x74\x69\x6f\x6e\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x4e\x61\x6d\x65\x22\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x20\x52\x65\x64\x69\x72\x65\x63\x74\x69\x6f\x6e\x20\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x53\x79\x73\x74\x65\x6d\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x22\x3e\x43\x49\x4d\x5f\x43\x6f\x6d\x70\x75\x74\x65\x72\x53\x79\x73\x74\x65\x6d\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x53\x79\x73\x74\x65\x6d\x4e\x61\x6d\x65\x22\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x3c\x2f\x48\x65\x61\x64\x65\x72\x3e\x3c\x42\x6f\x64\x79\x3e\x3c\x67\x3a\x41\x4d\x54\x5f\x52\x65\x64\x69\x72\x65\x63\x74\x69\x6f\x6e\x53\x65\x72\x76\x69\x63\x65\x20\x78\x6d\x6c\x6e\x73\x3a\x67\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x69\x6e\x74\x65\x6c\x2e\x63\x6f\x6d\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x61\x6d\x74\x2d\x73\x63\x68\x65\x6d\x61\x2f\x31\x2f\x41\x4d\x54\x5f\x52\x65\x64\x69\x72\x65\x63\x74\x69\x6f\x6e\x53\x65\x72\x76\x69\x63\x65\x22\x3e\x3c\x67\x3a\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x3e\x41\x4d\x54\x5f\x52\x65\x64\x69\x72\x65\x63\x74\x69\x6f\x6e\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x67\x3a\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x3e\x3c\x67\x3a\x45\x6c\x65\x6d\x65\x6e\x74\x4e\x61\x6d\x65\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x20\x52\x65\x64\x69\x72\x65\x63\x74\x69\x6f\x6e\x20\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x67\x3a\x45\x6c\x65\x6d\x65\x6e\x74\x4e\x61\x6d\x65\x3e\x3c\x67\x3a\x45\x6e\x61\x62\x6c\x65\x64\x53\x74\x61\x74\x65\x3e\x33\x32\x37\x37\x30\x3c\x2f\x67\x3a\x45\x6e\x61\x62\x6c\x65\x64\x53\x74\x61\x74\x65\x3e\x3c\x67\x3a\x4c\x69\x73\x74\x65\x6e\x65\x72\x45\x6e\x61\x62\x6c\x65\x64\x3e\x66\x61\x6c\x73\x65\x3c\x2f\x67\x3a\x4c\x69\x73\x74\x65\x6e\x65\x72\x45\x6e\x61\x62\x6c\x65\x64\x3e\x3c\x67\x3a\x4e\x61\x6d\x65\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x20\x52\x65\x64\x69\x72\x65\x63\x74\x69\x6f\x6e\x20\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x67\x3a\x4e\x61\x6d\x65\x3e\x3c\x67\x3a\x53\x79\x73\x74\x65\x6d\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x3e\x43\x49\x4d\x5f\x43\x6f\x6d\x70\x75\x74\x65\x72\x53\x79\x73\x74\x65\x6d\x3c\x2f\x67\x3a\x53\x79\x73\x74\x65\x6d\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x3e\x3c\x67\x3a\x53\x79\x73\x74\x65\x6d\x4e\x61\x6d\x65\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x3c\x2f\x67\x3a\x53\x79\x73\x74\x65\x6d\x4e\x61\x6d\x65\x3e\x3c\x2f\x67\x3a\x41\x4d\x54\x5f\x52\x65\x64\x69\x72\x65\x63\x74\x69\x6f\x6e\x53\x65\x72\x76\x69\x63\x65\x3e\x3c\x2f\x42\x6f\x64\x79\x3e\x3c\x2f\x45\x6e\x76\x65\x6c\x6f\x70\x65\x3e\x0a"
-
-func wsman_solredir_disableBytes() ([]byte, error) {
-	return bindataRead(
-		_wsman_solredir_disable,
-		"wsman_solredir_disable",
-	)
-}
-
-func wsman_solredir_disable() (*asset, error) {
-	bytes, err := wsman_solredir_disableBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "wsman_solredir_disable", size: 1885, mode: os.FileMode(420), modTime: time.Unix(1491066347, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _wsman_solredir_enable = "\x3c\x3f\x78\x6d\x6c\x20\x76\x65\x72\x73\x69\x6f\x6e\x3d\x22\x31\x2e\x30\x22\x20\x65\x6e\x63\x6f\x64\x69\x6e\x67\x3d\x22\x75\x74\x66\x2d\x38\x22\x3f\x3e\x3c\x45\x6e\x76\x65\x6c\x6f\x70\x65\x20\x78\x6d\x6c\x6e\x73\x3a\x78\x73\x69\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x31\x2f\x58\x4d\x4c\x53\x63\x68\x65\x6d\x61\x2d\x69\x6e\x73\x74\x61\x6e\x63\x65\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x78\x73\x64\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x31\x2f\x58\x4d\x4c\x53\x63\x68\x65\x6d\x61\x22\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x33\x2f\x30\x35\x2f\x73\x6f\x61\x70\x2d\x65\x6e\x76\x65\x6c\x6f\x70\x65\x22\x3e\x3c\x48\x65\x61\x64\x65\x72\x3e\x3c\x41\x63\x74\x69\x6f\x6e\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x39\x2f\x74\x72\x61\x6e\x73\x66\x65\x72\x2f\x50\x75\x74\x3c\x2f\x41\x63\x74\x69\x6f\x6e\x3e\x3c\x54\x6f\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x31\x39\x32\x2e\x31\x36\x38\x2e\x30\x2e\x31\x30\x30\x3a\x31\x36\x39\x39\x32\x2f\x77\x73\x6d\x61\x6e\x3c\x2f\x54\x6f\x3e\x3c\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x69\x6e\x74\x65\x6c\x2e\x63\x6f\x6d\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x61\x6d\x74\x2d\x73\x63\x68\x65\x6d\x61\x2f\x31\x2f\x41\x4d\x54\x5f\x52\x65\x64\x69\x72\x65\x63\x74\x69\x6f\x6e\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x3c\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x3e\x61\x66\x38\x62\x62\x61\x38\x39\x2d\x62\x64\x39\x35\x2d\x34\x61\x39\x34\x2d\x62\x31\x34\x64\x2d\x35\x37\x36\x39\x38\x32\x65\x36\x30\x62\x37\x39\x3c\x2f\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x3e\x3c\x52\x65\x70\x6c\x79\x54\x6f\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x3e\x3c\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x41\x64\x64\x72\x65\x73\x73\x3e\x3c\x2f\x52\x65\x70\x6c\x79\x54\x6f\x3e\x3c\x4f\x70\x65\x72\x61\x74\x69\x6f\x6e\x54\x69\x6d\x65\x6f\x75\x74\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x3e\x50\x54\x36\x30\x2e\x30\x30\x30\x53\x3c\x2f\x4f\x70\x65\x72\x61\x74\x69\x6f\x6e\x54\x69\x6d\x65\x6f\x75\x74\x3e\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x6d\x61\x6e\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x3e\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x22\x3e\x41\x4d\x54\x5f\x52\x65\x64\x69\x72\x65\x63\x74\x69\x6f\x6e\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x4e\x61\x6d\x65\x22\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x20\x52\x65\x64\x69\x72\x65\x63\x74\x69\x6f\x6e\x20\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x53\x79\x73\x74\x65\x6d\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x22\x3e\x43\x49\x4d\x5f\x43\x6f\x6d\x70\x75\x74\x65\x72\x53\x79\x73\x74\x65\x6d\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x53\x79\x73\x74\x65\x6d\x4e\x61\x6d\x65\x22\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x3c\x2f\x48\x65\x61\x64\x65\x72\x3e\x3c\x42\x6f\x64\x79\x3e\x3c\x67\x3a\x41\x4d\x54\x5f\x52\x65\x64\x69\x72\x65\x63\x74\x69\x6f\x6e\x53\x65\x72\x76\x69\x63\x65\x20\x78\x6d\x6c\x6e\x73\x3a\x67\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x69\x6e\x74\x65\x6c\x2e\x63\x6f\x6d\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x61\x6d\x74\x2d\x73\x63\x68\x65\x6d\x61\x2f\x31\x2f\x41\x4d\x54\x5f\x52\x65\x64\x69\x72\x65\x63\x74\x69\x6f\x6e\x53\x65\x72\x76\x69\x63\x65\x22\x3e\x3c\x67\x3a\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x3e\x41\x4d\x54\x5f\x52\x65\x64\x69\x72\x65\x63\x74\x69\x6f\x6e\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x67\x3a\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x3e\x3c\x67\x3a\x45\x6c\x65\x6d\x65\x6e\x74\x4e\x61\x6d\x65\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x20\x52\x65\x64\x69\x72\x65\x63\x74\x69\x6f\x6e\x20\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x67\x3a\x45\x6c\x65\x6d\x65\x6e\x74\x4e\x61\x6d\x65\x3e\x3c\x67\x3a\x45\x6e\x61\x62\x6c\x65\x64\x53\x74\x61\x74\x65\x3e\x33\x32\x37\x37\x30\x3c\x2f\x67\x3a\x45\x6e\x61\x62\x6c\x65\x64\x53\x74\x61\x74\x65\x3e\x3c\x67\x3a\x4c\x69\x73\x74\x65\x6e\x65\x72\x45\x6e\x61\x62\x6c\x65\x64\x3e\x74\x72\x75\x65\x3c\x2f\x67\x3a\x4c\x69\x73\x74\x65\x6e\x65\x72\x45\x6e\x61\x62\x6c\x65\x64\x3e\x3c\x67\x3a\x4e\x61\x6d\x65\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x20\x52\x65\x64\x69\x72\x65\x63\x74\x69\x6f\x6e\x20\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x67\x3a\x4e\x61\x6d\x65\x3e\x3c\x67\x3a\x53\x79\x73\x74\x65\x6d\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x3e\x43\x49\x4d\x5f\x43\x6f\x6d\x70\x75\x74\x65\x72\x53\x79\x73\x74\x65\x6d\x3c\x2f\x67\x3a\x53\x79\x73\x74\x65\x6d\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x3e\x3c\x67\x3a\x53\x79\x73\x74\x65\x6d\x4e\x61\x6d\x65\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x3c\x2f\x67\x3a\x53\x79\x73\x74\x65\x6d\x4e\x61\x6d\x65\x3e\x3c\x2f\x67\x3a\x41\x4d\x54\x5f\x52\x65\x64\x69\x72\x65\x63\x74\x69\x6f\x6e\x53\x65\x72\x76\x69\x63\x65\x3e\x3c\x2f\x42\x6f\x64\x79\x3e\x3c\x2f\x45\x6e\x76\x65\x6c\x6f\x70\x65\x3e\x0a"
-
-func wsman_solredir_enableBytes() ([]byte, error) {
-	return bindataRead(
-		_wsman_solredir_enable,
-		"wsman_solredir_enable",
-	)
-}
-
-func wsman_solredir_enable() (*asset, error) {
-	bytes, err := wsman_solredir_enableBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "wsman_solredir_enable", size: 1884, mode: os.FileMode(420), modTime: time.Unix(1491066347, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _wsman_up = "\x3c\x3f\x78\x6d\x6c\x20\x76\x65\x72\x73\x69\x6f\x6e\x3d\x22\x31\x2e\x30\x22\x20\x65\x6e\x63\x6f\x64\x69\x6e\x67\x3d\x22\x55\x54\x46\x2d\x38\x22\x3f\x3e\x0a\x3c\x73\x3a\x45\x6e\x76\x65\x6c\x6f\x70\x65\x20\x78\x6d\x6c\x6e\x73\x3a\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x33\x2f\x30\x35\x2f\x73\x6f\x61\x70\x2d\x65\x6e\x76\x65\x6c\x6f\x70\x65\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x61\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x6d\x61\x6e\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x6e\x31\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x50\x6f\x77\x65\x72\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x53\x65\x72\x76\x69\x63\x65\x22\x3e\x0a\x3c\x73\x3a\x48\x65\x61\x64\x65\x72\x3e\x0a\x20\x20\x3c\x77\x73\x61\x3a\x41\x63\x74\x69\x6f\x6e\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x50\x6f\x77\x65\x72\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x53\x65\x72\x76\x69\x63\x65\x2f\x52\x65\x71\x75\x65\x73\x74\x50\x6f\x77\x65\x72\x53\x74\x61\x74\x65\x43\x68\x61\x6e\x67\x65\x3c\x2f\x77\x73\x61\x3a\x41\x63\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x3c\x77\x73\x61\x3a\x54\x6f\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x31\x39\x32\x2e\x31\x36\x38\x2e\x30\x2e\x31\x30\x30\x3a\x31\x36\x39\x39\x32\x2f\x77\x73\x6d\x61\x6e\x3c\x2f\x77\x73\x61\x3a\x54\x6f\x3e\x0a\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x50\x6f\x77\x65\x72\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x0a\x20\x20\x3c\x77\x73\x61\x3a\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x75\x75\x69\x64\x3a\x66\x63\x36\x66\x32\x62\x63\x33\x2d\x34\x39\x38\x65\x2d\x34\x35\x66\x36\x2d\x38\x34\x65\x30\x2d\x35\x66\x37\x62\x32\x36\x36\x35\x39\x30\x33\x65\x3c\x2f\x77\x73\x61\x3a\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x3e\x0a\x20\x20\x3c\x77\x73\x61\x3a\x52\x65\x70\x6c\x79\x54\x6f\x3e\x3c\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x3c\x2f\x77\x73\x61\x3a\x52\x65\x70\x6c\x79\x54\x6f\x3e\x0a\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x4e\x61\x6d\x65\x22\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x20\x50\x6f\x77\x65\x72\x20\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x20\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x53\x79\x73\x74\x65\x6d\x4e\x61\x6d\x65\x22\x3e\x49\x6e\x74\x65\x6c\x28\x72\x29\x20\x41\x4d\x54\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x22\x3e\x43\x49\x4d\x5f\x50\x6f\x77\x65\x72\x4d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x53\x79\x73\x74\x65\x6d\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x22\x3e\x43\x49\x4d\x5f\x43\x6f\x6d\x70\x75\x74\x65\x72\x53\x79\x73\x74\x65\x6d\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x3c\x2f\x73\x3a\x48\x65\x61\x64\x65\x72\x3e\x0a\x3c\x73\x3a\x42\x6f\x64\x79\x3e\x0a\x20\x20\x3c\x6e\x31\x3a\x52\x65\x71\x75\x65\x73\x74\x50\x6f\x77\x65\x72\x53\x74\x61\x74\x65\x43\x68\x61\x6e\x67\x65\x5f\x49\x4e\x50\x55\x54\x3e\x0a\x20\x20\x20\x20\x3c\x6e\x31\x3a\x50\x6f\x77\x65\x72\x53\x74\x61\x74\x65\x3e\x32\x3c\x2f\x6e\x31\x3a\x50\x6f\x77\x65\x72\x53\x74\x61\x74\x65\x3e\x0a\x20\x20\x20\x20\x3c\x6e\x31\x3a\x4d\x61\x6e\x61\x67\x65\x64\x45\x6c\x65\x6d\x65\x6e\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x61\x3a\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x50\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x63\x69\x6d\x2d\x73\x63\x68\x65\x6d\x61\x2f\x32\x2f\x43\x49\x4d\x5f\x43\x6f\x6d\x70\x75\x74\x65\x72\x53\x79\x73\x74\x65\x6d\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x4e\x61\x6d\x65\x22\x3e\x4d\x61\x6e\x61\x67\x65\x64\x53\x79\x73\x74\x65\x6d\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x20\x4e\x61\x6d\x65\x3d\x22\x43\x72\x65\x61\x74\x69\x6f\x6e\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x22\x3e\x43\x49\x4d\x5f\x43\x6f\x6d\x70\x75\x74\x65\x72\x53\x79\x73\x74\x65\x6d\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x53\x65\x6c\x65\x63\x74\x6f\x72\x53\x65\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x77\x73\x61\x3a\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x50\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x6e\x31\x3a\x4d\x61\x6e\x61\x67\x65\x64\x45\x6c\x65\x6d\x65\x6e\x74\x3e\x0a\x20\x20\x3c\x2f\x6e\x31\x3a\x52\x65\x71\x75\x65\x73\x74\x50\x6f\x77\x65\x72\x53\x74\x61\x74\x65\x43\x68\x61\x6e\x67\x65\x5f\x49\x4e\x50\x55\x54\x3e\x0a\x3c\x2f\x73\x3a\x42\x6f\x64\x79\x3e\x0a\x3c\x2f\x73\x3a\x45\x6e\x76\x65\x6c\x6f\x70\x65\x3e\x0a"
-
-func wsman_upBytes() ([]byte, error) {
-	return bindataRead(
-		_wsman_up,
-		"wsman_up",
-	)
-}
-
-func wsman_up() (*asset, error) {
-	bytes, err := wsman_upBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "wsman_up", size: 1985, mode: os.FileMode(420), modTime: time.Unix(1491066347, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _wsman_webui_disable = "\x3c\x3f\x78\x6d\x6c\x20\x76\x65\x72\x73\x69\x6f\x6e\x3d\x22\x31\x2e\x30\x22\x20\x65\x6e\x63\x6f\x64\x69\x6e\x67\x3d\x22\x75\x74\x66\x2d\x38\x22\x3f\x3e\x3c\x45\x6e\x76\x65\x6c\x6f\x70\x65\x20\x78\x6d\x6c\x6e\x73\x3a\x78\x73\x69\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x31\x2f\x58\x4d\x4c\x53\x63\x68\x65\x6d\x61\x2d\x69\x6e\x73\x74\x61\x6e\x63\x65\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x78\x73\x64\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x31\x2f\x58\x4d\x4c\x53\x63\x68\x65\x6d\x61\x22\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x33\x2f\x30\x35\x2f\x73\x6f\x61\x70\x2d\x65\x6e\x76\x65\x6c\x6f\x70\x65\x22\x3e\x3c\x48\x65\x61\x64\x65\x72\x3e\x3c\x41\x63\x74\x69\x6f\x6e\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x69\x6e\x74\x65\x6c\x2e\x63\x6f\x6d\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x61\x6d\x74\x2d\x73\x63\x68\x65\x6d\x61\x2f\x31\x2f\x41\x4d\x54\x5f\x57\x65\x62\x55\x49\x53\x65\x72\x76\x69\x63\x65\x2f\x52\x65\x71\x75\x65\x73\x74\x53\x74\x61\x74\x65\x43\x68\x61\x6e\x67\x65\x3c\x2f\x41\x63\x74\x69\x6f\x6e\x3e\x3c\x54\x6f\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x31\x39\x32\x2e\x31\x36\x38\x2e\x30\x2e\x31\x30\x30\x3a\x31\x36\x39\x39\x32\x2f\x77\x73\x6d\x61\x6e\x3c\x2f\x54\x6f\x3e\x3c\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x69\x6e\x74\x65\x6c\x2e\x63\x6f\x6d\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x61\x6d\x74\x2d\x73\x63\x68\x65\x6d\x61\x2f\x31\x2f\x41\x4d\x54\x5f\x57\x65\x62\x55\x49\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x3c\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x3e\x65\x64\x64\x32\x33\x31\x65\x33\x2d\x36\x61\x65\x39\x2d\x34\x66\x36\x38\x2d\x39\x37\x62\x65\x2d\x65\x35\x65\x33\x31\x32\x30\x33\x37\x61\x30\x30\x3c\x2f\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x3e\x3c\x52\x65\x70\x6c\x79\x54\x6f\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x3e\x3c\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x41\x64\x64\x72\x65\x73\x73\x3e\x3c\x2f\x52\x65\x70\x6c\x79\x54\x6f\x3e\x3c\x4f\x70\x65\x72\x61\x74\x69\x6f\x6e\x54\x69\x6d\x65\x6f\x75\x74\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x3e\x50\x54\x36\x30\x2e\x30\x30\x30\x53\x3c\x2f\x4f\x70\x65\x72\x61\x74\x69\x6f\x6e\x54\x69\x6d\x65\x6f\x75\x74\x3e\x3c\x2f\x48\x65\x61\x64\x65\x72\x3e\x3c\x42\x6f\x64\x79\x3e\x3c\x52\x65\x71\x75\x65\x73\x74\x53\x74\x61\x74\x65\x43\x68\x61\x6e\x67\x65\x5f\x49\x4e\x50\x55\x54\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x69\x6e\x74\x65\x6c\x2e\x63\x6f\x6d\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x61\x6d\x74\x2d\x73\x63\x68\x65\x6d\x61\x2f\x31\x2f\x41\x4d\x54\x5f\x57\x65\x62\x55\x49\x53\x65\x72\x76\x69\x63\x65\x22\x3e\x3c\x52\x65\x71\x75\x65\x73\x74\x65\x64\x53\x74\x61\x74\x65\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x69\x6e\x74\x65\x6c\x2e\x63\x6f\x6d\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x61\x6d\x74\x2d\x73\x63\x68\x65\x6d\x61\x2f\x31\x2f\x41\x4d\x54\x5f\x57\x65\x62\x55\x49\x53\x65\x72\x76\x69\x63\x65\x22\x3e\x33\x3c\x2f\x52\x65\x71\x75\x65\x73\x74\x65\x64\x53\x74\x61\x74\x65\x3e\x3c\x2f\x52\x65\x71\x75\x65\x73\x74\x53\x74\x61\x74\x65\x43\x68\x61\x6e\x67\x65\x5f\x49\x4e\x50\x55\x54\x3e\x3c\x2f\x42\x6f\x64\x79\x3e\x3c\x2f\x45\x6e\x76\x65\x6c\x6f\x70\x65\x3e\x0a"
-
-func wsman_webui_disableBytes() ([]byte, error) {
-	return bindataRead(
-		_wsman_webui_disable,
-		"wsman_webui_disable",
-	)
-}
-
-func wsman_webui_disable() (*asset, error) {
-	bytes, err := wsman_webui_disableBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "wsman_webui_disable", size: 1226, mode: os.FileMode(420), modTime: time.Unix(1491066347, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _wsman_webui_enable = "\x3c\x3f\x78\x6d\x6c\x20\x76\x65\x72\x73\x69\x6f\x6e\x3d\x22\x31\x2e\x30\x22\x20\x65\x6e\x63\x6f\x64\x69\x6e\x67\x3d\x22\x75\x74\x66\x2d\x38\x22\x3f\x3e\x3c\x45\x6e\x76\x65\x6c\x6f\x70\x65\x20\x78\x6d\x6c\x6e\x73\x3a\x78\x73\x69\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x31\x2f\x58\x4d\x4c\x53\x63\x68\x65\x6d\x61\x2d\x69\x6e\x73\x74\x61\x6e\x63\x65\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x78\x73\x64\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x31\x2f\x58\x4d\x4c\x53\x63\x68\x65\x6d\x61\x22\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x33\x2f\x30\x35\x2f\x73\x6f\x61\x70\x2d\x65\x6e\x76\x65\x6c\x6f\x70\x65\x22\x3e\x3c\x48\x65\x61\x64\x65\x72\x3e\x3c\x41\x63\x74\x69\x6f\x6e\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x69\x6e\x74\x65\x6c\x2e\x63\x6f\x6d\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x61\x6d\x74\x2d\x73\x63\x68\x65\x6d\x61\x2f\x31\x2f\x41\x4d\x54\x5f\x57\x65\x62\x55\x49\x53\x65\x72\x76\x69\x63\x65\x2f\x52\x65\x71\x75\x65\x73\x74\x53\x74\x61\x74\x65\x43\x68\x61\x6e\x67\x65\x3c\x2f\x41\x63\x74\x69\x6f\x6e\x3e\x3c\x54\x6f\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x31\x39\x32\x2e\x31\x36\x38\x2e\x30\x2e\x31\x30\x30\x3a\x31\x36\x39\x39\x32\x2f\x77\x73\x6d\x61\x6e\x3c\x2f\x54\x6f\x3e\x3c\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x69\x6e\x74\x65\x6c\x2e\x63\x6f\x6d\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x61\x6d\x74\x2d\x73\x63\x68\x65\x6d\x61\x2f\x31\x2f\x41\x4d\x54\x5f\x57\x65\x62\x55\x49\x53\x65\x72\x76\x69\x63\x65\x3c\x2f\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x3c\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x3e\x66\x63\x65\x30\x35\x62\x36\x62\x2d\x34\x63\x38\x36\x2d\x34\x37\x62\x32\x2d\x61\x36\x39\x31\x2d\x32\x38\x35\x62\x63\x38\x32\x33\x64\x30\x36\x34\x3c\x2f\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x3e\x3c\x52\x65\x70\x6c\x79\x54\x6f\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x3e\x3c\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x41\x64\x64\x72\x65\x73\x73\x3e\x3c\x2f\x52\x65\x70\x6c\x79\x54\x6f\x3e\x3c\x4f\x70\x65\x72\x61\x74\x69\x6f\x6e\x54\x69\x6d\x65\x6f\x75\x74\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x3e\x50\x54\x36\x30\x2e\x30\x30\x30\x53\x3c\x2f\x4f\x70\x65\x72\x61\x74\x69\x6f\x6e\x54\x69\x6d\x65\x6f\x75\x74\x3e\x3c\x2f\x48\x65\x61\x64\x65\x72\x3e\x3c\x42\x6f\x64\x79\x3e\x3c\x52\x65\x71\x75\x65\x73\x74\x53\x74\x61\x74\x65\x43\x68\x61\x6e\x67\x65\x5f\x49\x4e\x50\x55\x54\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x69\x6e\x74\x65\x6c\x2e\x63\x6f\x6d\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x61\x6d\x74\x2d\x73\x63\x68\x65\x6d\x61\x2f\x31\x2f\x41\x4d\x54\x5f\x57\x65\x62\x55\x49\x53\x65\x72\x76\x69\x63\x65\x22\x3e\x3c\x52\x65\x71\x75\x65\x73\x74\x65\x64\x53\x74\x61\x74\x65\x20\x78\x6d\x6c\x6e\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x69\x6e\x74\x65\x6c\x2e\x63\x6f\x6d\x2f\x77\x62\x65\x6d\x2f\x77\x73\x63\x69\x6d\x2f\x31\x2f\x61\x6d\x74\x2d\x73\x63\x68\x65\x6d\x61\x2f\x31\x2f\x41\x4d\x54\x5f\x57\x65\x62\x55\x49\x53\x65\x72\x76\x69\x63\x65\x22\x3e\x32\x3c\x2f\x52\x65\x71\x75\x65\x73\x74\x65\x64\x53\x74\x61\x74\x65\x3e\x3c\x2f\x52\x65\x71\x75\x65\x73\x74\x53\x74\x61\x74\x65\x43\x68\x61\x6e\x67\x65\x5f\x49\x4e\x50\x55\x54\x3e\x3c\x2f\x42\x6f\x64\x79\x3e\x3c\x2f\x45\x6e\x76\x65\x6c\x6f\x70\x65\x3e\x0a"
-
-func wsman_webui_enableBytes() ([]byte, error) {
-	return bindataRead(
-		_wsman_webui_enable,
-		"wsman_webui_enable",
-	)
-}
-
-func wsman_webui_enable() (*asset, error) {
-	bytes, err := wsman_webui_enableBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "wsman_webui_enable", size: 1226, mode: os.FileMode(420), modTime: time.Unix(1491066347, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _wsman_xenum = "\x3c\x3f\x78\x6d\x6c\x20\x76\x65\x72\x73\x69\x6f\x6e\x3d\x22\x31\x2e\x30\x22\x20\x65\x6e\x63\x6f\x64\x69\x6e\x67\x3d\x22\x55\x54\x46\x2d\x38\x22\x3f\x3e\x0a\x3c\x73\x3a\x45\x6e\x76\x65\x6c\x6f\x70\x65\x20\x78\x6d\x6c\x6e\x73\x3a\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x33\x2f\x30\x35\x2f\x73\x6f\x61\x70\x2d\x65\x6e\x76\x65\x6c\x6f\x70\x65\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x61\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x6d\x61\x6e\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x65\x6e\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x39\x2f\x65\x6e\x75\x6d\x65\x72\x61\x74\x69\x6f\x6e\x22\x3e\x3c\x73\x3a\x48\x65\x61\x64\x65\x72\x3e\x3c\x77\x73\x61\x3a\x41\x63\x74\x69\x6f\x6e\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x39\x2f\x65\x6e\x75\x6d\x65\x72\x61\x74\x69\x6f\x6e\x2f\x45\x6e\x75\x6d\x65\x72\x61\x74\x65\x3c\x2f\x77\x73\x61\x3a\x41\x63\x74\x69\x6f\x6e\x3e\x3c\x77\x73\x61\x3a\x54\x6f\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x31\x39\x32\x2e\x31\x36\x38\x2e\x30\x2e\x31\x30\x30\x3a\x31\x36\x39\x39\x32\x2f\x77\x73\x6d\x61\x6e\x3c\x2f\x77\x73\x61\x3a\x54\x6f\x3e\x3c\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x25\x73\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x3c\x77\x73\x61\x3a\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x75\x75\x69\x64\x3a\x64\x66\x39\x32\x37\x31\x36\x34\x2d\x38\x36\x34\x31\x2d\x34\x36\x34\x61\x2d\x38\x61\x37\x36\x2d\x32\x30\x62\x62\x32\x63\x35\x34\x63\x32\x32\x33\x3c\x2f\x77\x73\x61\x3a\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x3e\x3c\x77\x73\x61\x3a\x52\x65\x70\x6c\x79\x54\x6f\x3e\x3c\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x3c\x2f\x77\x73\x61\x3a\x52\x65\x70\x6c\x79\x54\x6f\x3e\x3c\x2f\x73\x3a\x48\x65\x61\x64\x65\x72\x3e\x3c\x73\x3a\x42\x6f\x64\x79\x3e\x3c\x77\x73\x65\x6e\x3a\x45\x6e\x75\x6d\x65\x72\x61\x74\x65\x3e\x3c\x77\x73\x6d\x61\x6e\x3a\x4f\x70\x74\x69\x6d\x69\x7a\x65\x45\x6e\x75\x6d\x65\x72\x61\x74\x69\x6f\x6e\x2f\x3e\x3c\x77\x73\x6d\x61\x6e\x3a\x45\x6e\x75\x6d\x65\x72\x61\x74\x69\x6f\x6e\x4d\x6f\x64\x65\x3e\x45\x6e\x75\x6d\x65\x72\x61\x74\x65\x4f\x62\x6a\x65\x63\x74\x41\x6e\x64\x45\x50\x52\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x45\x6e\x75\x6d\x65\x72\x61\x74\x69\x6f\x6e\x4d\x6f\x64\x65\x3e\x3c\x77\x73\x6d\x61\x6e\x3a\x4d\x61\x78\x45\x6c\x65\x6d\x65\x6e\x74\x73\x3e\x32\x35\x30\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x4d\x61\x78\x45\x6c\x65\x6d\x65\x6e\x74\x73\x3e\x3c\x2f\x77\x73\x65\x6e\x3a\x45\x6e\x75\x6d\x65\x72\x61\x74\x65\x3e\x3c\x2f\x73\x3a\x42\x6f\x64\x79\x3e\x3c\x2f\x73\x3a\x45\x6e\x76\x65\x6c\x6f\x70\x65\x3e\x0a"
-
-func wsman_xenumBytes() ([]byte, error) {
-	return bindataRead(
-		_wsman_xenum,
-		"wsman_xenum",
-	)
-}
-
-func wsman_xenum() (*asset, error) {
-	bytes, err := wsman_xenumBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "wsman_xenum", size: 968, mode: os.FileMode(420), modTime: time.Unix(1491066347, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _wsman_xenum_step2 = "\x3c\x3f\x78\x6d\x6c\x20\x76\x65\x72\x73\x69\x6f\x6e\x3d\x22\x31\x2e\x30\x22\x20\x65\x6e\x63\x6f\x64\x69\x6e\x67\x3d\x22\x55\x54\x46\x2d\x38\x22\x3f\x3e\x0a\x3c\x73\x3a\x45\x6e\x76\x65\x6c\x6f\x70\x65\x20\x78\x6d\x6c\x6e\x73\x3a\x73\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x77\x77\x77\x2e\x77\x33\x2e\x6f\x72\x67\x2f\x32\x30\x30\x33\x2f\x30\x35\x2f\x73\x6f\x61\x70\x2d\x65\x6e\x76\x65\x6c\x6f\x70\x65\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x61\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x6d\x61\x6e\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x64\x6d\x74\x66\x2e\x6f\x72\x67\x2f\x77\x62\x65\x6d\x2f\x77\x73\x6d\x61\x6e\x2f\x31\x2f\x77\x73\x6d\x61\x6e\x2e\x78\x73\x64\x22\x20\x78\x6d\x6c\x6e\x73\x3a\x77\x73\x65\x6e\x3d\x22\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x39\x2f\x65\x6e\x75\x6d\x65\x72\x61\x74\x69\x6f\x6e\x22\x3e\x3c\x73\x3a\x48\x65\x61\x64\x65\x72\x3e\x3c\x77\x73\x61\x3a\x41\x63\x74\x69\x6f\x6e\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x39\x2f\x65\x6e\x75\x6d\x65\x72\x61\x74\x69\x6f\x6e\x2f\x50\x75\x6c\x6c\x3c\x2f\x77\x73\x61\x3a\x41\x63\x74\x69\x6f\x6e\x3e\x3c\x77\x73\x61\x3a\x54\x6f\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x31\x39\x32\x2e\x31\x36\x38\x2e\x30\x2e\x31\x30\x30\x3a\x31\x36\x39\x39\x32\x2f\x77\x73\x6d\x61\x6e\x3c\x2f\x77\x73\x61\x3a\x54\x6f\x3e\x3c\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x25\x73\x3c\x2f\x77\x73\x6d\x61\x6e\x3a\x52\x65\x73\x6f\x75\x72\x63\x65\x55\x52\x49\x3e\x3c\x77\x73\x61\x3a\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x20\x73\x3a\x6d\x75\x73\x74\x55\x6e\x64\x65\x72\x73\x74\x61\x6e\x64\x3d\x22\x74\x72\x75\x65\x22\x3e\x75\x75\x69\x64\x3a\x39\x38\x65\x61\x38\x61\x30\x66\x2d\x62\x39\x38\x39\x2d\x34\x38\x64\x31\x2d\x38\x35\x66\x38\x2d\x34\x65\x62\x34\x38\x38\x35\x38\x62\x66\x64\x36\x3c\x2f\x77\x73\x61\x3a\x4d\x65\x73\x73\x61\x67\x65\x49\x44\x3e\x3c\x77\x73\x61\x3a\x52\x65\x70\x6c\x79\x54\x6f\x3e\x3c\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x68\x74\x74\x70\x3a\x2f\x2f\x73\x63\x68\x65\x6d\x61\x73\x2e\x78\x6d\x6c\x73\x6f\x61\x70\x2e\x6f\x72\x67\x2f\x77\x73\x2f\x32\x30\x30\x34\x2f\x30\x38\x2f\x61\x64\x64\x72\x65\x73\x73\x69\x6e\x67\x2f\x72\x6f\x6c\x65\x2f\x61\x6e\x6f\x6e\x79\x6d\x6f\x75\x73\x3c\x2f\x77\x73\x61\x3a\x41\x64\x64\x72\x65\x73\x73\x3e\x3c\x2f\x77\x73\x61\x3a\x52\x65\x70\x6c\x79\x54\x6f\x3e\x3c\x2f\x73\x3a\x48\x65\x61\x64\x65\x72\x3e\x3c\x73\x3a\x42\x6f\x64\x79\x3e\x3c\x77\x73\x65\x6e\x3a\x50\x75\x6c\x6c\x3e\x3c\x77\x73\x65\x6e\x3a\x45\x6e\x75\x6d\x65\x72\x61\x74\x69\x6f\x6e\x43\x6f\x6e\x74\x65\x78\x74\x3e\x25\x73\x3c\x2f\x77\x73\x65\x6e\x3a\x45\x6e\x75\x6d\x65\x72\x61\x74\x69\x6f\x6e\x43\x6f\x6e\x74\x65\x78\x74\x3e\x3c\x77\x73\x65\x6e\x3a\x4d\x61\x78\x45\x6c\x65\x6d\x65\x6e\x74\x73\x3e\x32\x35\x30\x3c\x2f\x77\x73\x65\x6e\x3a\x4d\x61\x78\x45\x6c\x65\x6d\x65\x6e\x74\x73\x3e\x3c\x2f\x77\x73\x65\x6e\x3a\x50\x75\x6c\x6c\x3e\x3c\x2f\x73\x3a\x42\x6f\x64\x79\x3e\x3c\x2f\x73\x3a\x45\x6e\x76\x65\x6c\x6f\x70\x65\x3e\x0a"
-
-func wsman_xenum_step2Bytes() ([]byte, error) {
-	return bindataRead(
-		_wsman_xenum_step2,
-		"wsman_xenum_step2",
-	)
-}
-
-func wsman_xenum_step2() (*asset, error) {
-	bytes, err := wsman_xenum_step2Bytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "wsman_xenum_step2", size: 908, mode: os.FileMode(420), modTime: time.Unix(1491066347, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-// Asset loads and returns the asset for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func Asset(name string) ([]byte, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
-		}
-		return a.bytes, nil
-	}
-	return nil, fmt.Errorf("Asset %s not found", name)
-}
-
-// MustAsset is like Asset but panics when Asset would return an error.
-// It simplifies safe initialization of global variables.
-func MustAsset(name string) []byte {
-	a, err := Asset(name)
-	if err != nil {
-		panic("asset: Asset(" + name + "): " + err.Error())
-	}
-
-	return a
-}
-
-// AssetInfo loads and returns the asset info for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func AssetInfo(name string) (os.FileInfo, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
-		}
-		return a.info, nil
-	}
-	return nil, fmt.Errorf("AssetInfo %s not found", name)
-}
-
-// AssetNames returns the names of the assets.
-func AssetNames() []string {
-	names := make([]string, 0, len(_bindata))
-	for name := range _bindata {
-		names = append(names, name)
-	}
-	return names
-}
-
-// _bindata is a table, holding each asset generator, mapped to its name.
-var _bindata = map[string]func() (*asset, error){
-	"wsman_bootconfig": wsman_bootconfig,
-	"wsman_down": wsman_down,
-	"wsman_hddboot": wsman_hddboot,
-	"wsman_info": wsman_info,
-	"wsman_info_step2": wsman_info_step2,
-	"wsman_ping_disable": wsman_ping_disable,
-	"wsman_ping_enable": wsman_ping_enable,
-	"wsman_pxeboot": wsman_pxeboot,
-	"wsman_reset": wsman_reset,
-	"wsman_reset_graceful": wsman_reset_graceful,
-	"wsman_shutdown_graceful": wsman_shutdown_graceful,
-	"wsman_solredir_disable": wsman_solredir_disable,
-	"wsman_solredir_enable": wsman_solredir_enable,
-	"wsman_up": wsman_up,
-	"wsman_webui_disable": wsman_webui_disable,
-	"wsman_webui_enable": wsman_webui_enable,
-	"wsman_xenum": wsman_xenum,
-	"wsman_xenum_step2": wsman_xenum_step2,
-}
-
-// AssetDir returns the file names below a certain
-// directory embedded in the file by go-bindata.
-// For example if you run go-bindata on data/... and data contains the
-// following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
-// then AssetDir("data") would return []string{"foo.txt", "img"}
-// AssetDir("data/img") would return []string{"a.png", "b.png"}
-// AssetDir("foo.txt") and AssetDir("notexist") would return an error
-// AssetDir("") will return []string{"data"}.
-func AssetDir(name string) ([]string, error) {
-	node := _bintree
-	if len(name) != 0 {
-		cannonicalName := strings.Replace(name, "\\", "/", -1)
-		pathList := strings.Split(cannonicalName, "/")
-		for _, p := range pathList {
-			node = node.Children[p]
-			if node == nil {
-				return nil, fmt.Errorf("Asset %s not found", name)
-			}
-		}
-	}
-	if node.Func != nil {
-		return nil, fmt.Errorf("Asset %s not found", name)
-	}
-	rv := make([]string, 0, len(node.Children))
-	for childName := range node.Children {
-		rv = append(rv, childName)
-	}
-	return rv, nil
-}
-
-type bintree struct {
-	Func     func() (*asset, error)
-	Children map[string]*bintree
-}
-var _bintree = &bintree{nil, map[string]*bintree{
-	"wsman_bootconfig": &bintree{wsman_bootconfig, map[string]*bintree{}},
-	"wsman_down": &bintree{wsman_down, map[string]*bintree{}},
-	"wsman_hddboot": &bintree{wsman_hddboot, map[string]*bintree{}},
-	"wsman_info": &bintree{wsman_info, map[string]*bintree{}},
-	"wsman_info_step2": &bintree{wsman_info_step2, map[string]*bintree{}},
-	"wsman_ping_disable": &bintree{wsman_ping_disable, map[string]*bintree{}},
-	"wsman_ping_enable": &bintree{wsman_ping_enable, map[string]*bintree{}},
-	"wsman_pxeboot": &bintree{wsman_pxeboot, map[string]*bintree{}},
-	"wsman_reset": &bintree{wsman_reset, map[string]*bintree{}},
-	"wsman_reset_graceful": &bintree{wsman_reset_graceful, map[string]*bintree{}},
-	"wsman_shutdown_graceful": &bintree{wsman_shutdown_graceful, map[string]*bintree{}},
-	"wsman_solredir_disable": &bintree{wsman_solredir_disable, map[string]*bintree{}},
-	"wsman_solredir_enable": &bintree{wsman_solredir_enable, map[string]*bintree{}},
-	"wsman_up": &bintree{wsman_up, map[string]*bintree{}},
-	"wsman_webui_disable": &bintree{wsman_webui_disable, map[string]*bintree{}},
-	"wsman_webui_enable": &bintree{wsman_webui_enable, map[string]*bintree{}},
-	"wsman_xenum": &bintree{wsman_xenum, map[string]*bintree{}},
-	"wsman_xenum_step2": &bintree{wsman_xenum_step2, map[string]*bintree{}},
-}}
-
-// RestoreAsset restores an asset under the given directory
-func RestoreAsset(dir, name string) error {
-	data, err := Asset(name)
-	if err != nil {
-		return err
-	}
-	info, err := AssetInfo(name)
-	if err != nil {
-		return err
-	}
-	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
-	if err != nil {
-		return err
-	}
-	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
-	if err != nil {
-		return err
-	}
-	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// RestoreAssets restores an asset under the given directory recursively
-func RestoreAssets(dir, name string) error {
-	children, err := AssetDir(name)
-	// File
-	if err != nil {
-		return RestoreAsset(dir, name)
-	}
-	// Dir
-	for _, child := range children {
-		err = RestoreAssets(dir, filepath.Join(name, child))
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func _filePath(dir, name string) string {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
-}
-
@@ -0,0 +1,95 @@
+package amt
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// IPS_OptInService OptInRequired values
+const (
+	optInNone = 0
+	optInKVM  = 1
+	optInAll  = 0xFFFFFFFF
+)
+
+// IPS_OptInService OptInState values
+const (
+	optInStateNotStarted = 0
+	optInStateRequested  = 1
+	optInStateDisplayed  = 2
+	optInStateReceived   = 3
+	optInStateInSession  = 4
+)
+
+// SetOptInRequired sets which redirection sessions require user consent.
+func (c *Client) SetOptInRequired(required uint32) error {
+	return c.modify(ipsOptInService, nil,
+		Property{Name: "OptInRequired", Value: strconv.FormatUint(uint64(required), 10)})
+}
+
+// OptInState returns the current user consent state.
+func (c *Client) OptInState() (int, error) {
+	var service struct {
+		OptInState int
+	}
+	err := c.Get(ipsOptInService, nil, &service)
+	return service.OptInState, err
+}
+
+// StartOptIn displays a user consent code on the host's screen.
+func (c *Client) StartOptIn() error {
+	var output struct{ ReturnValue int }
+	if err := c.Invoke(ipsOptInService, "StartOptIn", nil, &output); err != nil {
+		return err
+	}
+	return checkReturnValue("StartOptIn", output.ReturnValue)
+}
+
+// SendOptInCode submits the user consent code shown on the host's screen.
+func (c *Client) SendOptInCode(code int) error {
+	var output struct{ ReturnValue int }
+	err := c.Invoke(ipsOptInService, "SendOptInCode", nil, &output,
+		Property{Name: "OptInCode", Value: strconv.Itoa(code)})
+	if err != nil {
+		return err
+	}
+	return checkReturnValue("SendOptInCode", output.ReturnValue)
+}
+
+// CliConsent requests user consent on each host, prompting for the code displayed.
+func CliConsent(hosts []string, options Optionset) {
+	if len(hosts) == 0 {
+		fmt.Println("Error: Expected list of hostnames as arguments")
+		return
+	}
+	options = cliOptions(options)
+
+	input := bufio.NewScanner(os.Stdin)
+	for _, host := range hosts {
+		client := NewClient(host, options)
+		state, err := client.OptInState()
+		if err == nil && state >= optInStateReceived {
+			fmt.Printf("%s: user consent already given\n", host)
+			continue
+		}
+		if err == nil && state == optInStateNotStarted {
+			err = client.StartOptIn()
+		}
+		if err == nil {
+			fmt.Printf("%s: enter user consent code shown on screen: ", host)
+			input.Scan()
+			var code int
+			if code, err = strconv.Atoi(strings.TrimSpace(input.Text())); err == nil {
+				err = client.SendOptInCode(code)
+			}
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: Error: %s\n", host, err)
+			continue
+		}
+		fmt.Printf("%s: user consent given\n", host)
+	}
+}
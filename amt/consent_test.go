@@ -0,0 +1,43 @@
+package amt
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSetOptInRequired(t *testing.T) {
+	server, client := fakeAMT(t, func(action string, envelope string) (int, string) {
+		switch action {
+		case actionGet:
+			return 200, testResponse(action+"Response", `<g:IPS_OptInService xmlns:g="`+ipsOptInService+`">`+
+				`<g:CanModifyOptInPolicy>1</g:CanModifyOptInPolicy><g:Name>Intel(r) AMT OptIn Service</g:Name>`+
+				`<g:OptInRequired>1</g:OptInRequired><g:OptInState>0</g:OptInState></g:IPS_OptInService>`)
+		case actionPut:
+			for _, expected := range []string{"<h:OptInRequired>4294967295</h:OptInRequired>",
+				"<h:Name>Intel(r) AMT OptIn Service</h:Name>"} {
+				if !strings.Contains(envelope, expected) {
+					t.Errorf("Put lacks %s:\n%s", expected, envelope)
+				}
+			}
+			return 200, testResponse(action+"Response", "")
+		}
+		t.Errorf("Unexpected action %s", action)
+		return 400, ""
+	})
+	defer server.Close()
+
+	if err := client.SetOptInRequired(optInAll); err != nil {
+		t.Errorf("SetOptInRequired failed: %s", err)
+	}
+	if state, err := client.OptInState(); err != nil || state != optInStateNotStarted {
+		t.Errorf("Unexpected OptInState %d (%v)", state, err)
+	}
+}
+
+func TestValidRFBPassword(t *testing.T) {
+	for password, valid := range map[string]bool{"Pa$$w0rd": true, "password": false, "Pa$$w0rd1": false, "Passw0rd": false} {
+		if err := validRFBPassword(password); (err == nil) != valid {
+			t.Errorf("validRFBPassword(%s): expected valid=%v, got %v", password, valid, err)
+		}
+	}
+}
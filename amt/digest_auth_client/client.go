@@ -0,0 +1,184 @@
+package digestAuthClient
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// idle keep-alive connections are kept for reuse by subsequent requests,
+// e.g. the next monitoring pass
+const idleConnTimeout = 30 * time.Second
+
+// ErrInvalidCACert is returned if CA cert data contains no PEM certificate.
+var ErrInvalidCACert = errors.New("failed to parse root certificate")
+
+// Client executes digest auth requests against a single host. It keeps
+// keep-alive connections and the negotiated nonce, so only the first
+// request (or one with a stale nonce) requires a challenge round trip.
+// A Client is safe for concurrent use.
+type Client struct {
+	Username   string
+	Password   string
+	httpClient *http.Client
+	mutex      sync.Mutex
+	wa         *wwwAuthenticate
+	auth       *authorization
+}
+
+// NewClient returns a Client using given credentials and TLS settings.
+// Without skipVerify, TLS certs are verified using CA certs in caCertData;
+// ErrInvalidCACert is returned if caCertData can't be parsed.
+func NewClient(username string, password string, timeout time.Duration, skipVerify bool, caCertData []byte) (*Client, error) {
+	tr, err := newTransport(timeout, skipVerify, caCertData)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		Username:   username,
+		Password:   password,
+		httpClient: &http.Client{Timeout: timeout, Transport: tr},
+	}, nil
+}
+
+// newTransport returns a keep-alive transport.
+func newTransport(timeout time.Duration, skipVerify bool, caCertData []byte) (*http.Transport, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: true}
+	if !skipVerify && len(caCertData) > 0 {
+		// enable TLS CA cert verification
+		roots := x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(caCertData) {
+			return nil, ErrInvalidCACert
+		}
+		tlsConfig = &tls.Config{InsecureSkipVerify: false, RootCAs: roots}
+	}
+	return &http.Transport{
+		DialTLSContext: (&tls.Dialer{
+			NetDialer: &net.Dialer{Timeout: timeout},
+			Config:    tlsConfig,
+		}).DialContext,
+		DialContext: (&net.Dialer{
+			Timeout:   timeout,
+			KeepAlive: 5 * time.Second,
+		}).DialContext,
+		MaxIdleConnsPerHost: 2,
+		IdleConnTimeout:     idleConnTimeout,
+	}, nil
+}
+
+// Do sends body to uri using method. The first request answers the
+// host's challenge; later ones reuse its nonce. If the host rejects the
+// nonce as stale, Do answers the new challenge once. Other 401 responses
+// (bad credentials) are returned.
+func (c *Client) Do(method string, uri string, body string) (*http.Response, error) {
+	return c.DoContext(context.Background(), method, uri, body)
+}
+
+// DoContext is like Do, but cancels requests once ctx is done.
+func (c *Client) DoContext(ctx context.Context, method string, uri string, body string) (*http.Response, error) {
+	dr := DigestRequest{Method: method, URI: uri, Body: body, Username: c.Username, Password: c.Password}
+	authString, err := c.authorize(&dr)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.send(ctx, &dr, authString)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	waString := resp.Header.Get("WWW-Authenticate")
+	if waString == "" {
+		discard(resp)
+		return nil, fmt.Errorf("Failed to get WWW-Authenticate header, please check your server configuration")
+	}
+	wa := newWwwAuthenticate(waString)
+	if authString != "" && !wa.Stale {
+		// negotiated nonce is valid, but credentials were rejected
+		c.forget(dr.Wa.Nonce)
+		return resp, nil
+	}
+	discard(resp)
+	if authString, err = c.challenge(&dr, wa); err != nil {
+		return nil, err
+	}
+	if resp, err = c.send(ctx, &dr, authString); err == nil && resp.StatusCode == http.StatusUnauthorized {
+		c.forget(dr.Wa.Nonce)
+	}
+	return resp, err
+}
+
+// CloseIdleConnections closes keep-alive connections not in use.
+func (c *Client) CloseIdleConnections() {
+	if tr, ok := c.httpClient.Transport.(*http.Transport); ok {
+		tr.CloseIdleConnections()
+	}
+}
+
+// authorize returns the Authorization header for dr using the negotiated
+// nonce, incrementing its nonce count; or "" if no nonce is known yet.
+func (c *Client) authorize(dr *DigestRequest) (string, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.auth == nil {
+		return "", nil
+	}
+	dr.Wa = c.wa
+	if _, err := c.auth.refreshAuthorization(dr); err != nil {
+		return "", err
+	}
+	return c.auth.toString(), nil
+}
+
+// challenge answers the challenge wa, which replaces the negotiated nonce.
+func (c *Client) challenge(dr *DigestRequest, wa *wwwAuthenticate) (string, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	dr.Wa = wa
+	auth, err := newAuthorization(dr)
+	if err != nil {
+		return "", err
+	}
+	c.wa = wa
+	c.auth = auth
+	return auth.toString(), nil
+}
+
+// forget drops the negotiated nonce after it got rejected, unless
+// another request negotiated a new one meanwhile.
+func (c *Client) forget(nonce string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.wa != nil && c.wa.Nonce == nonce {
+		c.wa = nil
+		c.auth = nil
+	}
+}
+
+// send executes dr, with Authorization header authString if non-empty.
+func (c *Client) send(ctx context.Context, dr *DigestRequest, authString string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, dr.Method, dr.URI, bytes.NewReader([]byte(dr.Body)))
+	if err != nil {
+		return nil, err
+	}
+	if authString != "" {
+		req.Header.Add("Authorization", authString)
+	}
+	req.Header.Add("Content-Type", "text/xml; charset=utf-8")
+	//debug(httputil.DumpRequestOut(req, true))
+	return c.httpClient.Do(req)
+}
+
+// discard reads and closes the body of resp, allowing connection reuse.
+func discard(resp *http.Response) {
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+}
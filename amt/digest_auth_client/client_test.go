@@ -0,0 +1,155 @@
+package digestAuthClient
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestClientReusesNonce(t *testing.T) {
+	nonce, stale := "n1", "false"
+	var challenges, requests int
+	var ncs []string
+	connections := make(map[string]bool)
+	ncRegex := regexp.MustCompile(`nc=([0-9a-f]+)`)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		connections[r.RemoteAddr] = true
+		auth := r.Header.Get("Authorization")
+		if auth == "" || !regexp.MustCompile(`nonce="`+nonce+`"`).MatchString(auth) {
+			challenges++
+			w.Header().Set("WWW-Authenticate", `Digest realm="Digest:1234", nonce="`+nonce+`", stale="`+stale+`", qop="auth"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		ncs = append(ncs, ncRegex.FindStringSubmatch(auth)[1])
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("admin", "secret", 5*time.Second, true, nil)
+	if err != nil {
+		t.Fatalf("NewClient failed: %s", err)
+	}
+	do := func() int {
+		resp, err := client.Do("POST", server.URL+"/wsman", "<x/>")
+		if err != nil {
+			t.Fatalf("Do failed: %s", err)
+		}
+		discard(resp)
+		return resp.StatusCode
+	}
+	for i := 0; i < 3; i++ {
+		if status := do(); status != http.StatusOK {
+			t.Fatalf("Unexpected status %d", status)
+		}
+	}
+	if challenges != 1 || requests != 4 {
+		t.Errorf("Expected 1 challenge in 4 requests, got %d in %d", challenges, requests)
+	}
+	if len(ncs) != 3 || ncs[0] != "00000001" || ncs[2] != "00000003" {
+		t.Errorf("Unexpected nonce counts %v", ncs)
+	}
+	if len(connections) != 1 {
+		t.Errorf("Expected a single keep-alive connection, got %d", len(connections))
+	}
+
+	nonce, stale = "n2", "true" // server considers n1 stale
+	if status := do(); status != http.StatusOK || challenges != 2 || requests != 6 {
+		t.Errorf("Expected re-challenge on stale nonce, got HTTP %d, %d challenges in %d requests", status, challenges, requests)
+	}
+
+	nonce, stale = "n3", "false" // n2 rejected without stale: no retry
+	if status := do(); status != http.StatusUnauthorized || requests != 7 {
+		t.Errorf("Expected 401 without retry, got HTTP %d after %d requests", status, requests)
+	}
+}
+
+func TestClientInvalidCACert(t *testing.T) {
+	if _, err := NewClient("admin", "secret", time.Second, false, []byte("no PEM")); err != ErrInvalidCACert {
+		t.Errorf("Expected ErrInvalidCACert, got %v", err)
+	}
+	dr := NewRequest("admin", "secret", "POST", "https://localhost:16993/wsman", "", time.Second, false, []byte("no PEM"))
+	if _, err := dr.Execute(); err != ErrInvalidCACert {
+		t.Errorf("Expected ErrInvalidCACert from Execute, got %v", err)
+	}
+}
+
+func TestClientTLS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			w.Header().Set("WWW-Authenticate", `Digest realm="Digest:1234", nonce="n1", qop="auth"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("admin", "secret", time.Second, true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Do("POST", server.URL+"/wsman", "")
+	if err != nil {
+		t.Fatalf("Expected TLS request to succeed, got %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected HTTP 200, got %d", resp.StatusCode)
+	}
+}
+
+func TestClientCancelsTLSHandshake(t *testing.T) {
+	// accepts a connection, but never answers the TLS handshake
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	closed := make(chan bool)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		ioutil.ReadAll(conn) // until the client hangs up
+		closed <- true
+	}()
+
+	client, err := NewClient("admin", "secret", time.Minute, true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err = client.DoContext(ctx, "POST", "https://"+listener.Addr().String()+"/wsman", ""); err == nil {
+		t.Error("Expected error for hung TLS handshake")
+	}
+	// recent Go versions keep dialing for later requests, until closed
+	client.CloseIdleConnections()
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Error("Expected hung TLS handshake to be cancelled")
+	}
+}
+
+func TestWwwAuthenticateStale(t *testing.T) {
+	for header, stale := range map[string]bool{
+		`Digest realm="r", nonce="n", stale="true", qop="auth"`: true,
+		`Digest realm="r", nonce="n", stale=TRUE, qop="auth"`:   true,
+		`Digest realm="r", nonce="n", stale="false"`:            false,
+		`Digest realm="r", nonce="n"`:                           false,
+	} {
+		if wa := newWwwAuthenticate(header); wa.Stale != stale {
+			t.Errorf("%s: expected stale %v", header, stale)
+		}
+	}
+}
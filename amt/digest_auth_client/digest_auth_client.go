@@ -1,15 +1,13 @@
 // Package digestAuthClient implements HTTP digest auth for AMT.
 // It is based on https://github.com/xinsnake/go-http-digest-auth-client,
 // but was adapted for AMT usage: makes timeouts configurable, makes
-// TLS cert verification configurable, closes connections...
+// TLS cert verification configurable, keeps connections and nonces of
+// a host for reuse (see Client)...
 package digestAuthClient
 
 import (
-	"bytes"
-	"crypto/tls"
-	"crypto/x509"
+	"context"
 	"fmt"
-	"net"
 	"net/http"
 	"time"
 )
@@ -24,8 +22,8 @@ type DigestRequest struct {
 	Timeout    time.Duration
 	SkipCert   bool
 	CaCertData []byte
-	Auth       *authorization
 	Wa         *wwwAuthenticate
+	client     *Client
 }
 
 // NewRequest returns a new DigestRequest
@@ -50,156 +48,22 @@ func (dr *DigestRequest) UpdateRequest(username string,
 	return dr
 }
 
-// Execute executes as DigestRequest
+// Execute executes as DigestRequest. The connection and negotiated nonce
+// are reused by subsequent executions of dr.
 func (dr *DigestRequest) Execute() (resp *http.Response, err error) {
-	if dr.Auth == nil {
-		var req *http.Request
-		if req, err = http.NewRequest(dr.Method, dr.URI, bytes.NewReader([]byte(dr.Body))); err != nil {
-			return nil, err
-		}
-		req.Close = true
-
-		tr := &http.Transport{
-			DialTLS: func(network, addr string) (net.Conn, error) {
-				return tls.DialWithDialer(&net.Dialer{Timeout: dr.Timeout}, network, addr,
-					&tls.Config{MaxVersion: tls.VersionTLS10, InsecureSkipVerify: true})
-			},
-			IdleConnTimeout: 5 * time.Second,
-			DialContext: (&net.Dialer{
-				Timeout:   dr.Timeout,
-				KeepAlive: 5 * time.Second,
-				DualStack: false,
-			}).DialContext,
-		}
-
-		client := &http.Client{
-			Timeout: dr.Timeout,
-		}
-		if !dr.SkipCert && len(dr.CaCertData) > 0 {
-			// enable TLS CA cert verification
-			roots := x509.NewCertPool()
-			ok := roots.AppendCertsFromPEM(dr.CaCertData)
-			if !ok {
-				panic("failed to parse root certificate")
-			}
-			tr.TLSClientConfig = &tls.Config{MaxVersion: tls.VersionTLS10, RootCAs: roots}
-			tr.DialTLS = func(network, addr string) (net.Conn, error) {
-				return tls.DialWithDialer(&net.Dialer{Timeout: dr.Timeout}, network, addr,
-					&tls.Config{MaxVersion: tls.VersionTLS10, InsecureSkipVerify: false, RootCAs: roots})
-			}
-		}
-		client.Transport = tr
-		req.Header.Set("Connection", "close")
-
-		//debug(httputil.DumpRequestOut(req, true))
-		resp, err = client.Do(req)
-		if err != nil {
-			return nil, err
-		}
-		//debug(httputil.DumpResponse(resp, true))
-
-		if resp.StatusCode == 401 {
-			resp.Body.Close()
-			return dr.executeNewDigest(resp)
-		}
-		return
-	}
-
-	return dr.executeExistingDigest()
+	return dr.ExecuteContext(context.Background())
 }
 
-func (dr *DigestRequest) executeNewDigest(resp *http.Response) (*http.Response, error) {
-	var (
-		auth *authorization
-		err  error
-		wa   *wwwAuthenticate
-	)
-
-	waString := resp.Header.Get("WWW-Authenticate")
-	if waString == "" {
-		return nil, fmt.Errorf("Failed to get WWW-Authenticate header, please check your server configuration")
-	}
-	wa = newWwwAuthenticate(waString)
-	dr.Wa = wa
-
-	if auth, err = newAuthorization(dr); err != nil {
-		//fmt.Printf("ERR WITH newAuth: %s\n", err)
-		return nil, err
-	}
-	authString := auth.toString()
-
-	var r *http.Response
-	if r, err = dr.executeRequest(authString); err != nil {
-		//fmt.Printf("ERR WITH drExec: %s\n", err)
-		return nil, err
-	}
-	dr.Auth = auth
-	//debug(httputil.DumpResponse(resp, true))
-	return r, nil
-}
-
-func (dr *DigestRequest) executeExistingDigest() (*http.Response, error) {
-	var (
-		auth *authorization
-		err  error
-	)
-
-	if auth, err = dr.Auth.refreshAuthorization(dr); err != nil {
-		return nil, err
-	}
-	dr.Auth = auth
-
-	authString := dr.Auth.toString()
-	return dr.executeRequest(authString)
-}
-
-func (dr *DigestRequest) executeRequest(authString string) (*http.Response, error) {
-	var (
-		err error
-		req *http.Request
-	)
-
-	if req, err = http.NewRequest(dr.Method, dr.URI, bytes.NewReader([]byte(dr.Body))); err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Authorization", authString)
-	req.Header.Add("Content-Type", "text/xml; charset=utf-8")
-	req.Header.Set("Connection", "close")
-	req.Close = true
-
-	tr := &http.Transport{
-		DialTLS: func(network, addr string) (net.Conn, error) {
-			return tls.DialWithDialer(&net.Dialer{Timeout: dr.Timeout}, network, addr,
-				&tls.Config{MaxVersion: tls.VersionTLS10, InsecureSkipVerify: true})
-		},
-		IdleConnTimeout: 5 * time.Second,
-		DialContext: (&net.Dialer{
-			Timeout:   dr.Timeout,
-			KeepAlive: 5 * time.Second,
-			DualStack: false,
-		}).DialContext,
-	}
-
-	client := &http.Client{
-		Timeout: dr.Timeout,
-	}
-	if !dr.SkipCert && len(dr.CaCertData) > 0 {
-		// enable TLS CA cert verification
-		roots := x509.NewCertPool()
-		ok := roots.AppendCertsFromPEM(dr.CaCertData)
-		if !ok {
-			panic("failed to parse root certificate")
-		}
-		tr.DialTLS = func(network, addr string) (net.Conn, error) {
-			return tls.DialWithDialer(&net.Dialer{Timeout: dr.Timeout}, network, addr,
-				&tls.Config{MaxVersion: tls.VersionTLS10, InsecureSkipVerify: false, RootCAs: roots})
+// ExecuteContext is like Execute, but cancels the request once ctx is done.
+func (dr *DigestRequest) ExecuteContext(ctx context.Context) (resp *http.Response, err error) {
+	if dr.client == nil {
+		if dr.client, err = NewClient(dr.Username, dr.Password, dr.Timeout, dr.SkipCert, dr.CaCertData); err != nil {
+			return nil, err
 		}
 	}
-
-	client.Transport = tr
-	//debug(httputil.DumpRequestOut(req, true))
-	return client.Do(req)
+	dr.client.Username = dr.Username
+	dr.client.Password = dr.Password
+	return dr.client.DoContext(ctx, dr.Method, dr.URI, dr.Body)
 }
 
 func debug(data []byte, err error) {
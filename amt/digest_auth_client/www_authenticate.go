@@ -56,7 +56,7 @@ func newWwwAuthenticate(s string) *wwwAuthenticate {
 		wa.Realm = realmMatch[1]
 	}
 
-	staleRegex := regexp.MustCompile(`stale=([^ ,])"`)
+	staleRegex := regexp.MustCompile(`stale="?([^",]+)`)
 	staleMatch := staleRegex.FindStringSubmatch(s)
 	if staleMatch != nil {
 		wa.Stale = (strings.ToLower(staleMatch[1]) == "true")
@@ -68,7 +68,7 @@ func newWwwAuthenticate(s string) *wwwAuthenticate {
 		wa.Charset = charsetMatch[1]
 	}
 
-	userhashRegex := regexp.MustCompile(`userhash=([^ ,])"`)
+	userhashRegex := regexp.MustCompile(`userhash="?([^",]+)`)
 	userhashMatch := userhashRegex.FindStringSubmatch(s)
 	if userhashMatch != nil {
 		wa.Userhash = (strings.ToLower(userhashMatch[1]) == "true")
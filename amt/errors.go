@@ -0,0 +1,99 @@
+package amt
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	dac "github.com/schnoddelbotz/amtgo/amt/digest_auth_client"
+)
+
+// Errors returned by Client and Command may be inspected using errors.As,
+// e.g. to tell hosts rejecting credentials from unreachable ones.
+
+// ErrUnauthorized is returned if AMT rejects the credentials (HTTP 401).
+type ErrUnauthorized struct {
+	Hostname string
+}
+
+func (e *ErrUnauthorized) Error() string {
+	return fmt.Sprintf("%s (HTTP %d)", http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+}
+
+// ErrTimeout is returned if AMT didn't respond in time, or the request's
+// context deadline was exceeded.
+type ErrTimeout struct {
+	Err error
+}
+
+func (e *ErrTimeout) Error() string { return e.Err.Error() }
+func (e *ErrTimeout) Unwrap() error { return e.Err }
+
+// ErrTLSVerify is returned if the TLS certificate of AMT could not be
+// verified, including CA cert files that can't be parsed.
+type ErrTLSVerify struct {
+	Err error
+}
+
+func (e *ErrTLSVerify) Error() string { return e.Err.Error() }
+func (e *ErrTLSVerify) Unwrap() error { return e.Err }
+
+// ErrSOAPFault is a SOAP fault returned by AMT, e.g. for unknown classes.
+type ErrSOAPFault struct {
+	Code    string `xml:"Code>Value"`
+	Subcode string `xml:"Code>Subcode>Value"`
+	Reason  string `xml:"Reason>Text"`
+	Detail  string `xml:"Detail>FaultDetail"`
+}
+
+// FaultCode returns the subcode of the fault without namespace prefix,
+// e.g. DestinationUnreachable, or the code if there is no subcode.
+func (f *ErrSOAPFault) FaultCode() string {
+	if f.Subcode != "" {
+		return localName(f.Subcode)
+	}
+	return localName(f.Code)
+}
+
+func (f *ErrSOAPFault) Error() string {
+	return fmt.Sprintf("SOAP fault %s: %s", f.FaultCode(), strings.TrimSpace(f.Reason))
+}
+
+// ErrUnsupported is returned if a feature is not supported by the host's
+// AMT firmware; Detail tells AMT version and alternatives, if known.
+type ErrUnsupported struct {
+	Feature string
+	Detail  string
+}
+
+func (e *ErrUnsupported) Error() string {
+	if e.Detail == "" {
+		return e.Feature + " not supported by host"
+	}
+	return e.Feature + " not supported by host " + e.Detail
+}
+
+// transportError returns err of a failed HTTP request as ErrTimeout or
+// ErrTLSVerify, if applicable.
+func transportError(err error) error {
+	var (
+		netErr       net.Error
+		verifyErr    *tls.CertificateVerificationError
+		authorityErr x509.UnknownAuthorityError
+		hostnameErr  x509.HostnameError
+		invalidErr   x509.CertificateInvalidError
+	)
+	switch {
+	case errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()):
+		return &ErrTimeout{err}
+	case errors.Is(err, dac.ErrInvalidCACert) || errors.As(err, &verifyErr) ||
+		errors.As(err, &authorityErr) || errors.As(err, &hostnameErr) || errors.As(err, &invalidErr):
+		return &ErrTLSVerify{err}
+	}
+	return err
+}
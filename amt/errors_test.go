@@ -0,0 +1,61 @@
+package amt
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTypedErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("WWW-Authenticate", `Digest realm="Digest:1234", nonce="n1", qop="auth"`)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	client := NewClient("localhost", Optionset{OptTimeout: 5, Username: "admin", Password: "wrong"})
+	client.URL = server.URL + "/wsman"
+	_, err := client.PowerState()
+	var unauthorized *ErrUnauthorized
+	if !errors.As(err, &unauthorized) || err.Error() != "Unauthorized (HTTP 401)" {
+		t.Errorf("Expected *ErrUnauthorized, got %v", err)
+	}
+	server.Close()
+
+	blocked := make(chan bool)
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { <-blocked }))
+	defer slow.Close()
+	defer close(blocked)
+	client = NewClient("localhost", Optionset{OptTimeout: 5})
+	client.URL = slow.URL + "/wsman"
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = client.WithContext(ctx).PowerState()
+	var timeout *ErrTimeout
+	if !errors.As(err, &timeout) {
+		t.Errorf("Expected *ErrTimeout, got %v", err)
+	}
+
+	// invalid CA certs must not panic
+	client = NewClient("localhost", Optionset{OptTimeout: 1, SwUseTLS: 1, CaCertData: []byte("no PEM")})
+	_, err = client.PowerState()
+	var tlsVerify *ErrTLSVerify
+	if !errors.As(err, &tlsVerify) {
+		t.Errorf("Expected *ErrTLSVerify, got %v", err)
+	}
+}
+
+func TestCommandContextErr(t *testing.T) {
+	defer func() { PowerCapabilitiesStore = nil }()
+	PowerCapabilitiesStore = testCapabilitiesStore{"localhost": {Updated: int(time.Now().Unix()), AmtVersion: "5.2.1", PowerStates: "2,8"}}
+
+	result := CommandContext(context.Background(), Laststate{Hostname: "localhost"}, CmdCycle, Optionset{OptTimeout: 1})
+	var unsupported *ErrUnsupported
+	if !errors.As(result.Err, &unsupported) || unsupported.Feature != CmdCycle {
+		t.Errorf("Expected *ErrUnsupported, got %v", result.Err)
+	}
+	if result.Usermessage != result.Err.Error() {
+		t.Errorf("Usermessage %q differs from error %q", result.Usermessage, result.Err)
+	}
+}
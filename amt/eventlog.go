@@ -0,0 +1,257 @@
+package amt
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+)
+
+const amtMessageLog = uriAMT + "AMT_MessageLog"
+
+// maximum number of records requested per GetRecords call
+const messageLogBatchSize = 390
+
+// Event is a platform event record, as stored in AMT_MessageLog.
+type Event struct {
+	ID              int    `json:"id"`
+	HostID          int    `json:"host_id" db:"host_id"`
+	Time            int    `json:"time" db:"event_time"` // AMT local time as unix timestamp
+	DeviceAddress   int    `json:"device_address" db:"device_address"`
+	EventSensorType int    `json:"event_sensor_type" db:"event_sensor_type"`
+	EventType       int    `json:"event_type" db:"event_type"`
+	EventOffset     int    `json:"event_offset" db:"event_offset"`
+	EventSourceType int    `json:"event_source_type" db:"event_source_type"`
+	EventSeverity   int    `json:"event_severity" db:"event_severity"`
+	SensorNumber    int    `json:"sensor_number" db:"sensor_number"`
+	Entity          int    `json:"entity"`
+	EntityInstance  int    `json:"entity_instance" db:"entity_instance"`
+	EventData       string `json:"event_data" db:"event_data"` // hex
+	Description     string `json:"description"`
+	Record          string `json:"-"` // raw record, hex
+}
+
+// Events is ember array of Event
+type Events struct {
+	Events []Event `json:"events"`
+}
+
+var eventSeverityTextMap = map[int]string{
+	0x00: "Unspecified",
+	0x01: "Monitor",
+	0x02: "Information",
+	0x04: "OK",
+	0x08: "Non-critical",
+	0x10: "Critical",
+	0x20: "Non-recoverable",
+}
+
+var sensorTypeTextMap = map[int]string{
+	0x01: "Temperature",
+	0x02: "Voltage",
+	0x04: "Fan",
+	0x05: "Physical security",
+	0x06: "Platform security violation",
+	0x07: "Processor",
+	0x08: "Power supply",
+	0x0C: "Memory",
+	0x0F: "System firmware progress",
+	0x10: "Event logging disabled",
+	0x12: "System event",
+	0x13: "Critical interrupt",
+	0x1D: "System boot initiated",
+	0x1E: "Boot error",
+	0x1F: "OS boot",
+	0x20: "OS critical stop",
+	0x23: "Watchdog",
+	0x25: "Entity presence",
+}
+
+var firmwareErrorTextMap = map[int]string{
+	0:  "Unspecified firmware error",
+	1:  "No system memory installed",
+	2:  "No usable system memory",
+	3:  "Unrecoverable hard-disk/ATAPI/IDE device failure",
+	4:  "Unrecoverable system-board failure",
+	5:  "Unrecoverable diskette subsystem failure",
+	6:  "Unrecoverable hard-disk controller failure",
+	7:  "Unrecoverable PS/2 or USB keyboard failure",
+	8:  "Removable boot media not found",
+	9:  "Unrecoverable video controller failure",
+	10: "No video device detected",
+	11: "Firmware (BIOS) ROM corruption detected",
+	12: "CPU voltage mismatch",
+	13: "CPU speed matching failure",
+}
+
+var firmwareProgressTextMap = map[int]string{
+	0:  "Unspecified firmware progress",
+	1:  "Memory initialization",
+	2:  "Hard-disk initialization",
+	3:  "Secondary processor initialization",
+	4:  "User authentication",
+	5:  "User-initiated system setup",
+	6:  "USB resource configuration",
+	7:  "PCI resource configuration",
+	8:  "Option ROM initialization",
+	9:  "Video initialization",
+	10: "Cache initialization",
+	11: "SM Bus initialization",
+	12: "Keyboard controller initialization",
+	13: "Management controller initialization",
+	14: "Docking station attachment",
+	15: "Enabling docking station",
+	16: "Docking station ejection",
+	17: "Disabling docking station",
+	18: "Calling operating system wake-up vector",
+	19: "Starting operating system boot process",
+	20: "Baseboard initialization",
+	22: "Floppy initialization",
+	23: "Keyboard test",
+	24: "Pointing device test",
+	25: "Primary processor initialization",
+}
+
+// DecodeEvent decodes a 21 byte platform event record.
+func DecodeEvent(record []byte) (Event, error) {
+	if len(record) != 21 {
+		return Event{}, fmt.Errorf("invalid event record length %d", len(record))
+	}
+	e := Event{
+		Time:            int(binary.LittleEndian.Uint32(record[0:4])),
+		DeviceAddress:   int(record[4]),
+		EventSensorType: int(record[5]),
+		EventType:       int(record[6]),
+		EventOffset:     int(record[7]),
+		EventSourceType: int(record[8]),
+		EventSeverity:   int(record[9]),
+		SensorNumber:    int(record[10]),
+		Entity:          int(record[11]),
+		EntityInstance:  int(record[12]),
+		EventData:       hex.EncodeToString(record[13:21]),
+		Record:          hex.EncodeToString(record),
+	}
+	e.Description = eventDescription(e.EventSensorType, e.EventOffset, record[13:21])
+	return e, nil
+}
+
+func eventDescription(sensorType int, offset int, data []byte) string {
+	switch sensorType {
+	case 0x0F:
+		if offset == 0x00 {
+			return firmwareErrorTextMap[int(data[1])]
+		} else if offset == 0x02 {
+			return firmwareProgressTextMap[int(data[1])]
+		}
+		return "System firmware hang"
+	case 0x06:
+		return fmt.Sprintf("Authentication failed %d times, system may be under attack", int(data[3])+int(data[4])<<8)
+	case 0x1E:
+		return "No bootable media"
+	case 0x20:
+		return "Operating system lockup or power interrupt"
+	}
+	if text, ok := sensorTypeTextMap[sensorType]; ok {
+		return fmt.Sprintf("%s event, offset %d", text, offset)
+	}
+	return fmt.Sprintf("Unknown event, sensor type %d, offset %d", sensorType, offset)
+}
+
+// Severity returns the event's severity as text.
+func (e Event) Severity() string {
+	if text, ok := eventSeverityTextMap[e.EventSeverity]; ok {
+		return text
+	}
+	return fmt.Sprintf("Severity-%d", e.EventSeverity)
+}
+
+// EventLog reads all records of AMT_MessageLog.
+func (c *Client) EventLog() ([]Event, error) {
+	var position struct {
+		IterationIdentifier string
+		ReturnValue         int
+	}
+	if err := c.Invoke(amtMessageLog, "PositionToFirstRecord", nil, &position); err != nil {
+		return nil, err
+	}
+	if err := checkReturnValue("PositionToFirstRecord", position.ReturnValue); err != nil {
+		return nil, err
+	}
+
+	var events []Event
+	iteration := position.IterationIdentifier
+	for {
+		var records struct {
+			IterationIdentifier string
+			NoMoreRecords       bool
+			RecordArray         []string
+			ReturnValue         int
+		}
+		err := c.Invoke(amtMessageLog, "GetRecords", nil, &records,
+			Property{Name: "IterationIdentifier", Value: iteration},
+			Property{Name: "MaxReadRecords", Value: fmt.Sprint(messageLogBatchSize)})
+		if err != nil {
+			return nil, err
+		}
+		if err = checkReturnValue("GetRecords", records.ReturnValue); err != nil {
+			return nil, err
+		}
+		for _, encoded := range records.RecordArray {
+			record, err := base64.StdEncoding.DecodeString(encoded)
+			if err != nil {
+				return nil, err
+			}
+			event, err := DecodeEvent(record)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, event)
+		}
+		if records.NoMoreRecords || len(records.RecordArray) == 0 {
+			return events, nil
+		}
+		iteration = records.IterationIdentifier
+	}
+}
+
+// CliEventLog prints the event log of a list of hosts.
+func CliEventLog(hosts []string, format string, options Optionset) {
+	if len(hosts) == 0 {
+		fmt.Println("Error: Expected list of hostnames as arguments")
+		return
+	}
+	if format != FormatJSON && format != FormatTable {
+		fmt.Printf("Error: Unsupported output format %s\n", format)
+		return
+	}
+	options = cliOptions(options)
+
+	results := make(map[string][]Event)
+	for _, host := range hosts {
+		events, err := NewClient(host, options).EventLog()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: Error: %s\n", host, err)
+			continue
+		}
+		results[host] = events
+	}
+
+	if format == FormatJSON {
+		data, _ := json.MarshalIndent(results, "", "  ")
+		fmt.Println(string(data))
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Host\tTime\tSeverity\tSensor\tDescription")
+	for _, host := range hosts {
+		for _, e := range results[host] {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n", host, time.Unix(int64(e.Time), 0).UTC().Format("2006-01-02 15:04:05"),
+				e.Severity(), e.EventSensorType, e.Description)
+		}
+	}
+	w.Flush()
+}
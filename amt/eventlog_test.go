@@ -0,0 +1,79 @@
+package amt
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+// firmware progress record: "Starting operating system boot process"
+var testEventRecord = []byte{0x10, 0x5c, 0x8a, 0x59, 0x68, 0x0f, 0x6f, 0x02, 0x68, 0x02, 0xff, 0x22, 0x00,
+	0x40, 0x13, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
+
+func TestDecodeEvent(t *testing.T) {
+	e, err := DecodeEvent(testEventRecord)
+	if err != nil {
+		t.Fatalf("DecodeEvent failed: %s", err)
+	}
+	if e.Time != 0x598a5c10 || e.EventSensorType != 0x0f || e.EventOffset != 2 || e.Entity != 0x22 {
+		t.Errorf("Unexpected event fields: %+v", e)
+	}
+	if e.Description != "Starting operating system boot process" || e.Severity() != "Information" {
+		t.Errorf("Unexpected event description: %s (%s)", e.Description, e.Severity())
+	}
+	if e.EventData != "4013000000000000" || len(e.Record) != 42 {
+		t.Errorf("Unexpected event data: %s / %s", e.EventData, e.Record)
+	}
+	if _, err = DecodeEvent(testEventRecord[1:]); err == nil {
+		t.Errorf("DecodeEvent accepted short record")
+	}
+}
+
+func TestEventDescription(t *testing.T) {
+	data := make([]byte, 8)
+	for sensorType, description := range map[int]string{
+		0x1E: "No bootable media",
+		0x23: "Watchdog event, offset 1",
+		0x25: "Entity presence event, offset 1",
+		0x7F: "Unknown event, sensor type 127, offset 1",
+	} {
+		if text := eventDescription(sensorType, 1, data); text != description {
+			t.Errorf("Sensor type %#x: expected %s, got %s", sensorType, description, text)
+		}
+	}
+}
+
+func TestEventLog(t *testing.T) {
+	encoded := base64.StdEncoding.EncodeToString(testEventRecord)
+	calls := 0
+	server, client := fakeAMT(t, func(action string, envelope string) (int, string) {
+		switch action {
+		case amtMessageLog + "/PositionToFirstRecord":
+			return 200, testResponse(action+"Response", `<h:PositionToFirstRecord_OUTPUT>`+
+				`<h:IterationIdentifier>1</h:IterationIdentifier><h:ReturnValue>0</h:ReturnValue></h:PositionToFirstRecord_OUTPUT>`)
+		case amtMessageLog + "/GetRecords":
+			calls++
+			if !strings.Contains(envelope, "<h:MaxReadRecords>390</h:MaxReadRecords>") {
+				t.Errorf("GetRecords lacks MaxReadRecords:\n%s", envelope)
+			}
+			more := "false"
+			if calls == 2 {
+				more = "true"
+			}
+			return 200, testResponse(action+"Response", `<h:GetRecords_OUTPUT><h:IterationIdentifier>2</h:IterationIdentifier>`+
+				`<h:NoMoreRecords>`+more+`</h:NoMoreRecords><h:RecordArray>`+encoded+`</h:RecordArray>`+
+				`<h:RecordArray>`+encoded+`</h:RecordArray><h:ReturnValue>0</h:ReturnValue></h:GetRecords_OUTPUT>`)
+		}
+		t.Errorf("Unexpected action %s", action)
+		return 400, ""
+	})
+	defer server.Close()
+
+	events, err := client.EventLog()
+	if err != nil {
+		t.Fatalf("EventLog failed: %s", err)
+	}
+	if len(events) != 4 || calls != 2 {
+		t.Errorf("Expected 4 events in 2 calls, got %d in %d", len(events), calls)
+	}
+}
@@ -0,0 +1,137 @@
+package amt
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+)
+
+// ProvisioningMode values of AMT_SetupAndConfigurationService
+var provisioningModeTextMap = map[int]string{
+	0: "none",
+	1: "ACM", // admin control mode (enterprise mode before AMT 7)
+	3: "SMB", // small business mode, AMT 6 and older
+	4: "CCM", // client control mode
+}
+
+// ProvisioningState values of AMT_SetupAndConfigurationService
+var provisioningStateTextMap = map[int]string{
+	0: "pre",
+	1: "in",
+	2: "post",
+}
+
+// Firmware describes AMT firmware and provisioning of a host, from
+// CIM_SoftwareIdentity, AMT_SetupAndConfigurationService and AMT_GeneralSettings.
+type Firmware struct {
+	HostID            int    `json:"host_id" db:"host_id"` // one record per host
+	Updated           int    `json:"updated"`
+	AmtVersion        string `json:"amt_version" db:"amt_version"`
+	AmtBuild          string `json:"amt_build" db:"amt_build"`
+	AmtSku            string `json:"amt_sku" db:"amt_sku"`
+	ProvisioningMode  string `json:"provisioning_mode" db:"provisioning_mode"`   // ACM, CCM...
+	ProvisioningState string `json:"provisioning_state" db:"provisioning_state"` // pre, in, post
+	AmtHostname       string `json:"amt_hostname" db:"amt_hostname"`
+	AmtDomain         string `json:"amt_domain" db:"amt_domain"`
+	DigestRealm       string `json:"digest_realm" db:"digest_realm"`
+}
+
+// Firmware queries firmware version and provisioning state of the host.
+func (c *Client) Firmware() (Firmware, error) {
+	var firmware Firmware
+	instances, err := c.Enumerate(ResourceURI("CIM_SoftwareIdentity"))
+	if err != nil {
+		return firmware, err
+	}
+	for _, instance := range instances {
+		switch instance.Get("InstanceID") {
+		case "AMT":
+			firmware.AmtVersion = instance.Get("VersionString")
+		case "Build Number":
+			firmware.AmtBuild = instance.Get("VersionString")
+		case "Sku":
+			firmware.AmtSku = instance.Get("VersionString")
+		}
+	}
+
+	var setup struct {
+		ProvisioningMode  int
+		ProvisioningState int
+	}
+	if err = c.Get(amtSetupAndConfigurationService, nil, &setup); err != nil {
+		return firmware, err
+	}
+	firmware.ProvisioningMode = enumText(provisioningModeTextMap, setup.ProvisioningMode)
+	firmware.ProvisioningState = enumText(provisioningStateTextMap, setup.ProvisioningState)
+
+	var settings struct {
+		HostName    string
+		DomainName  string
+		DigestRealm string
+	}
+	if err = c.Get(amtGeneralSettings, nil, &settings); err != nil {
+		return firmware, err
+	}
+	firmware.AmtHostname = settings.HostName
+	firmware.AmtDomain = settings.DomainName
+	firmware.DigestRealm = settings.DigestRealm
+	firmware.Updated = int(time.Now().Unix())
+	return firmware, nil
+}
+
+// Version returns AMT version and build, e.g. 11.0.25.3001.
+func (f Firmware) Version() string {
+	if f.AmtBuild == "" {
+		return f.AmtVersion
+	}
+	return f.AmtVersion + "." + f.AmtBuild
+}
+
+// enumText returns the name of value in textMap, or value if unknown.
+func enumText(textMap map[int]string, value int) string {
+	if text, ok := textMap[value]; ok {
+		return text
+	}
+	return strconv.Itoa(value)
+}
+
+// CliFirmware queries and prints firmware and provisioning of a list of hosts.
+func CliFirmware(hosts []string, format string, options Optionset) {
+	if len(hosts) == 0 {
+		fmt.Println("Error: Expected list of hostnames as arguments")
+		return
+	}
+	if format != FormatJSON && format != FormatTable {
+		fmt.Printf("Error: Unsupported output format %s\n", format)
+		return
+	}
+	options = cliOptions(options)
+
+	results := make(map[string]Firmware)
+	for _, host := range hosts {
+		firmware, err := NewClient(host, options).Firmware()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: Error: %s\n", host, err)
+			continue
+		}
+		results[host] = firmware
+	}
+
+	if format == FormatJSON {
+		data, _ := json.MarshalIndent(results, "", "  ")
+		fmt.Println(string(data))
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Host\tAMT\tSKU\tMode\tState\tAMT hostname\tDomain\tDigest realm")
+	for _, host := range hosts {
+		if f, ok := results[host]; ok {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", host, f.Version(), f.AmtSku,
+				f.ProvisioningMode, f.ProvisioningState, f.AmtHostname, f.AmtDomain, f.DigestRealm)
+		}
+	}
+	w.Flush()
+}
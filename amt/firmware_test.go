@@ -0,0 +1,43 @@
+package amt
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFirmware(t *testing.T) {
+	server, client := fakeAMT(t, func(action string, envelope string) (int, string) {
+		switch {
+		case action == actionEnumerate:
+			return 200, testResponse(actionEnumerate+"Response", `<g:EnumerateResponse><g:EnumerationContext>1</g:EnumerationContext></g:EnumerateResponse>`)
+		case action == actionPull:
+			identity := func(id string, version string) string {
+				return `<h:CIM_SoftwareIdentity xmlns:h="` + uriCIM + `CIM_SoftwareIdentity"><h:InstanceID>` + id +
+					`</h:InstanceID><h:VersionString>` + version + `</h:VersionString></h:CIM_SoftwareIdentity>`
+			}
+			return 200, testResponse(actionPull+"Response", `<g:PullResponse><g:Items>`+
+				identity("Flash", "11.0.25")+identity("AMT", "11.0.25")+identity("Build Number", "3001")+
+				identity("Sku", "16392")+`</g:Items><g:EndOfSequence></g:EndOfSequence></g:PullResponse>`)
+		case action == actionGet && strings.Contains(envelope, amtSetupAndConfigurationService):
+			return 200, testResponse(actionGet+"Response", `<h:AMT_SetupAndConfigurationService xmlns:h="`+amtSetupAndConfigurationService+`">`+
+				`<h:ProvisioningMode>4</h:ProvisioningMode><h:ProvisioningState>2</h:ProvisioningState></h:AMT_SetupAndConfigurationService>`)
+		case action == actionGet && strings.Contains(envelope, amtGeneralSettings):
+			return 200, testResponse(actionGet+"Response", `<h:AMT_GeneralSettings xmlns:h="`+amtGeneralSettings+`">`+
+				`<h:DigestRealm>Digest:A3829B3827DE4D33D4449B366831B8F4</h:DigestRealm><h:DomainName>lab.example.com</h:DomainName>`+
+				`<h:HostName>labpc-e19-01</h:HostName></h:AMT_GeneralSettings>`)
+		}
+		return 400, ""
+	})
+	defer server.Close()
+
+	firmware, err := client.Firmware()
+	if err != nil {
+		t.Fatalf("Firmware failed: %s", err)
+	}
+	if firmware.Version() != "11.0.25.3001" || firmware.AmtSku != "16392" ||
+		firmware.ProvisioningMode != "CCM" || firmware.ProvisioningState != "post" ||
+		firmware.AmtHostname != "labpc-e19-01" || firmware.AmtDomain != "lab.example.com" ||
+		firmware.DigestRealm != "Digest:A3829B3827DE4D33D4449B366831B8F4" {
+		t.Errorf("Unexpected firmware %+v", firmware)
+	}
+}
@@ -0,0 +1,273 @@
+package amt
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Instance is a CIM instance of any class, as returned by enumerations.
+// Properties are kept in document order; multi-valued properties occur
+// once per value.
+type Instance struct {
+	Class       string
+	ResourceURI string
+	Properties  []Property
+}
+
+type propertyValue struct {
+	Text        string     `xml:",chardata"`
+	Datetime    string     `xml:"Datetime"`
+	ResourceURI string     `xml:"ReferenceParameters>ResourceURI"`
+	Selectors   []Selector `xml:"ReferenceParameters>SelectorSet>Selector"`
+}
+
+// ResourceURI returns the resource URI for a CIM, AMT or IPS class name.
+// Anything else, e.g. a complete URI, is returned unchanged.
+func ResourceURI(class string) string {
+	switch {
+	case strings.HasPrefix(class, "CIM_"):
+		return uriCIM + class
+	case strings.HasPrefix(class, "AMT_"):
+		return uriAMT + class
+	case strings.HasPrefix(class, "IPS_"):
+		return uriIPS + class
+	}
+	return class
+}
+
+// Get returns the (first) value of the named property.
+func (i Instance) Get(name string) string {
+	for _, p := range i.Properties {
+		if p.Name == name {
+			return p.Value
+		}
+	}
+	return ""
+}
+
+// Values returns all values of the named property.
+func (i Instance) Values(name string) (values []string) {
+	for _, p := range i.Properties {
+		if p.Name == name {
+			values = append(values, p.Value)
+		}
+	}
+	return
+}
+
+// Update replaces all values of each property given in properties.
+// Properties not present yet are appended.
+func (i *Instance) Update(properties ...Property) {
+	updates := Instance{Properties: properties}
+	for _, name := range updates.Names() {
+		var values []Property
+		for _, p := range properties {
+			if p.Name == name {
+				values = append(values, p)
+			}
+		}
+		var result []Property
+		for _, p := range i.Properties {
+			if p.Name != name {
+				result = append(result, p)
+			} else if values != nil {
+				result = append(result, values...)
+				values = nil
+			}
+		}
+		i.Properties = append(result, values...)
+	}
+}
+
+// Names returns the instance's property names in order, without duplicates.
+func (i Instance) Names() (names []string) {
+	seen := make(map[string]bool)
+	for _, p := range i.Properties {
+		if !seen[p.Name] {
+			seen[p.Name] = true
+			names = append(names, p.Name)
+		}
+	}
+	return
+}
+
+// String returns the property value; references are shown by selectors.
+func (p Property) String() string {
+	if p.Ref == nil {
+		return p.Value
+	}
+	var keys []string
+	for _, s := range p.Ref.Selectors {
+		keys = append(keys, s.Name+"="+s.Value)
+	}
+	return className(p.Ref.ResourceURI) + "(" + strings.Join(keys, ",") + ")"
+}
+
+// MarshalJSON encodes the instance as object of its properties,
+// preserving property order. Multi-valued properties become arrays.
+func (i Instance) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for n, name := range i.Names() {
+		if n > 0 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(name)
+		buf.Write(key)
+		buf.WriteByte(':')
+
+		var values []interface{}
+		for _, p := range i.Properties {
+			if p.Name == name {
+				values = append(values, p.jsonValue())
+			}
+		}
+		var value interface{} = values
+		if len(values) == 1 {
+			value = values[0]
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(data)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func (p Property) jsonValue() interface{} {
+	if p.Ref == nil {
+		return p.Value
+	}
+	selectors := make(map[string]string)
+	for _, s := range p.Ref.Selectors {
+		selectors[s.Name] = s.Value
+	}
+	return map[string]interface{}{
+		"ResourceURI": p.Ref.ResourceURI,
+		"Selectors":   selectors,
+	}
+}
+
+// ParseProperties reads properties from a JSON object, as written by
+// Instance.MarshalJSON, or from XML containing a single instance.
+func ParseProperties(data []byte) ([]Property, error) {
+	data = bytes.TrimSpace(data)
+	if bytes.HasPrefix(data, []byte("<")) {
+		instances, err := parseInstances(data)
+		if err != nil {
+			return nil, err
+		}
+		if len(instances) != 1 {
+			return nil, fmt.Errorf("expected a single instance, got %d", len(instances))
+		}
+		return instances[0].Properties, nil
+	}
+
+	// decode token-wise to keep property order
+	var properties []Property
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if token, err := d.Token(); err != nil || token != json.Delim('{') {
+		return nil, fmt.Errorf("expected JSON object or XML instance")
+	}
+	for d.More() {
+		token, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		name := token.(string)
+		var value interface{}
+		if err = d.Decode(&value); err != nil {
+			return nil, err
+		}
+		values, ok := value.([]interface{})
+		if !ok {
+			values = []interface{}{value}
+		}
+		for _, v := range values {
+			property, err := jsonProperty(name, v)
+			if err != nil {
+				return nil, err
+			}
+			properties = append(properties, property)
+		}
+	}
+	return properties, nil
+}
+
+func jsonProperty(name string, value interface{}) (Property, error) {
+	switch v := value.(type) {
+	case string:
+		return Property{Name: name, Value: v}, nil
+	case json.Number, bool:
+		return Property{Name: name, Value: fmt.Sprint(v)}, nil
+	case nil:
+		return Property{Name: name}, nil
+	case map[string]interface{}:
+		ref := &EndpointReference{}
+		ref.ResourceURI, _ = v["ResourceURI"].(string)
+		selectors, _ := v["Selectors"].(map[string]interface{})
+		for key, value := range selectors {
+			ref.Selectors = append(ref.Selectors, Selector{key, fmt.Sprint(value)})
+		}
+		if ref.ResourceURI == "" {
+			return Property{}, fmt.Errorf("property %s: reference lacks ResourceURI", name)
+		}
+		return Property{Name: name, Ref: ref}, nil
+	}
+	return Property{}, fmt.Errorf("property %s: unsupported value %v", name, value)
+}
+
+// parseInstances decodes a sequence of instance elements, as found in
+// enumeration items or Get responses.
+func parseInstances(data []byte) ([]Instance, error) {
+	var instances []Instance
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			return instances, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			instance, err := decodeInstance(d, start)
+			if err != nil {
+				return nil, err
+			}
+			instances = append(instances, instance)
+		}
+	}
+}
+
+func decodeInstance(d *xml.Decoder, start xml.StartElement) (Instance, error) {
+	instance := Instance{Class: start.Name.Local, ResourceURI: start.Name.Space}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return instance, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			var v propertyValue
+			if err = d.DecodeElement(&v, &t); err != nil {
+				return instance, err
+			}
+			property := Property{Name: t.Name.Local, Value: strings.TrimSpace(v.Text + v.Datetime)}
+			if v.ResourceURI != "" {
+				property.Value = ""
+				property.Ref = &EndpointReference{strings.TrimSpace(v.ResourceURI), v.Selectors}
+			}
+			instance.Properties = append(instance.Properties, property)
+		case xml.EndElement:
+			return instance, nil
+		}
+	}
+}
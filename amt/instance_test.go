@@ -0,0 +1,98 @@
+package amt
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestEnumerate(t *testing.T) {
+	pulls := 0
+	server, client := fakeAMT(t, func(action string, envelope string) (int, string) {
+		if !strings.Contains(envelope, ">"+uriCIM+"CIM_SoftwareIdentity</wsman:ResourceURI>") {
+			t.Errorf("Unexpected resource URI:\n%s", envelope)
+		}
+		switch action {
+		case actionEnumerate:
+			return 200, testResponse(nsEnumeration+"/EnumerateResponse",
+				`<g:EnumerateResponse><g:EnumerationContext>02000000</g:EnumerationContext></g:EnumerateResponse>`)
+		case actionPull:
+			pulls++
+			if pulls == 1 {
+				return 200, testResponse(nsEnumeration+"/PullResponse",
+					`<g:PullResponse><g:EnumerationContext>02000000</g:EnumerationContext><g:Items>`+
+						`<x:CIM_SoftwareIdentity xmlns:x="`+uriCIM+`CIM_SoftwareIdentity">`+
+						`<x:InstanceID>Flash</x:InstanceID><x:VersionString>11.8.50</x:VersionString></x:CIM_SoftwareIdentity>`+
+						`</g:Items></g:PullResponse>`)
+			}
+			return 200, testResponse(nsEnumeration+"/PullResponse",
+				`<g:PullResponse><g:Items>`+
+					`<y:CIM_SoftwareIdentity xmlns:y="`+uriCIM+`CIM_SoftwareIdentity" xmlns:b="`+nsAddressing+`" xmlns:c="`+nsWsman+`">`+
+					`<y:InstanceID>Netstack</y:InstanceID><y:Classifications>10</y:Classifications><y:Classifications>11</y:Classifications>`+
+					`<y:Owner><b:Address>`+addressAnonymous+`</b:Address><b:ReferenceParameters>`+
+					`<c:ResourceURI>`+cimComputerSystem+`</c:ResourceURI><c:SelectorSet><c:Selector Name="Name">ManagedSystem</c:Selector></c:SelectorSet>`+
+					`</b:ReferenceParameters></y:Owner></y:CIM_SoftwareIdentity>`+
+					`</g:Items><g:EndOfSequence/></g:PullResponse>`)
+		}
+		t.Errorf("Unexpected action %s", action)
+		return 400, ""
+	})
+	defer server.Close()
+
+	instances, err := client.Enumerate(ResourceURI("CIM_SoftwareIdentity"))
+	if err != nil {
+		t.Fatalf("Enumerate failed: %s", err)
+	}
+	if len(instances) != 2 || pulls != 2 {
+		t.Fatalf("Expected 2 instances in 2 pulls, got %d in %d", len(instances), pulls)
+	}
+	if instances[0].Class != "CIM_SoftwareIdentity" || instances[0].Get("VersionString") != "11.8.50" {
+		t.Errorf("Unexpected first instance: %+v", instances[0])
+	}
+	if values := instances[1].Values("Classifications"); len(values) != 2 || values[1] != "11" {
+		t.Errorf("Unexpected multi-valued property: %v", values)
+	}
+	if owner := instances[1].Properties[3]; owner.String() != "CIM_ComputerSystem(Name=ManagedSystem)" {
+		t.Errorf("Unexpected reference property: %s", owner)
+	}
+
+	data, err := json.Marshal(instances[1])
+	if err != nil {
+		t.Fatalf("MarshalJSON failed: %s", err)
+	}
+	expected := `{"InstanceID":"Netstack","Classifications":["10","11"],"Owner":{"ResourceURI":"` +
+		cimComputerSystem + `","Selectors":{"Name":"ManagedSystem"}}}`
+	if string(data) != expected {
+		t.Errorf("Unexpected JSON:\n%s\nexpected:\n%s", data, expected)
+	}
+}
+
+func TestParseProperties(t *testing.T) {
+	input := `{"ElementName":"host","DHCPEnabled":false,"Classifications":[10,11],` +
+		`"Owner":{"ResourceURI":"` + cimComputerSystem + `","Selectors":{"Name":"ManagedSystem"}}}`
+	properties, err := ParseProperties([]byte(input))
+	if err != nil {
+		t.Fatalf("ParseProperties failed: %s", err)
+	}
+	instance := Instance{Properties: properties}
+	data, _ := json.Marshal(instance)
+	expected := `{"ElementName":"host","DHCPEnabled":"false","Classifications":["10","11"],` +
+		`"Owner":{"ResourceURI":"` + cimComputerSystem + `","Selectors":{"Name":"ManagedSystem"}}}`
+	if string(data) != expected {
+		t.Errorf("Unexpected properties:\n%s\nexpected:\n%s", data, expected)
+	}
+
+	properties, err = ParseProperties([]byte(`<h:AMT_GeneralSettings xmlns:h="` + amtGeneralSettings + `">` +
+		`<h:PingResponseEnabled>true</h:PingResponseEnabled></h:AMT_GeneralSettings>`))
+	if err != nil || len(properties) != 1 || properties[0].Value != "true" {
+		t.Errorf("Unexpected XML properties: %v (%v)", properties, err)
+	}
+
+	instance.Update(Property{Name: "Classifications", Value: "3"}, Property{Name: "HostName", Value: "pc1"})
+	if names := instance.Names(); len(names) != 5 || names[2] != "Classifications" || names[4] != "HostName" {
+		t.Errorf("Unexpected property order after Update: %v", names)
+	}
+	if values := instance.Values("Classifications"); len(values) != 1 || values[0] != "3" {
+		t.Errorf("Unexpected values after Update: %v", values)
+	}
+}
@@ -0,0 +1,142 @@
+package amt
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+)
+
+// InventoryClasses lists the classes enumerated for hardware inventory
+var InventoryClasses = []string{
+	"CIM_ComputerSystemPackage",
+	"CIM_Chassis",
+	"CIM_Processor",
+	"CIM_PhysicalMemory",
+	"CIM_BIOSElement",
+	"CIM_SoftwareIdentity",
+}
+
+// Inventory summarizes hardware of a host, as collected via AMT.
+// Items hold all instances found, by class name.
+type Inventory struct {
+	HostID        int                   `json:"id" db:"host_id"` // one inventory per host
+	Updated       int                   `json:"updated"`
+	Manufacturer  string                `json:"manufacturer"`
+	Model         string                `json:"model"`
+	SerialNumber  string                `json:"serial_number" db:"serial_number"`
+	PlatformGUID  string                `json:"platform_guid" db:"platform_guid"`
+	BiosVendor    string                `json:"bios_vendor" db:"bios_vendor"`
+	BiosVersion   string                `json:"bios_version" db:"bios_version"`
+	BiosDate      string                `json:"bios_date" db:"bios_date"`
+	CPUCount      int                   `json:"cpu_count" db:"cpu_count"`
+	CPUMaxSpeed   int                   `json:"cpu_max_speed" db:"cpu_max_speed"` // MHz
+	MemoryMB      int                   `json:"memory_mb" db:"memory_mb"`
+	MemoryModules int                   `json:"memory_modules" db:"memory_modules"`
+	AmtVersion    string                `json:"amt_version" db:"amt_version"`
+	Items         map[string][]Instance `json:"items,omitempty" db:"-"`
+}
+
+// Inventories is ember array of Inventory
+type Inventories struct {
+	Inventories []Inventory `json:"inventories"`
+}
+
+// Inventory enumerates InventoryClasses and returns the host's inventory.
+// Classes unsupported by the firmware (SOAP faults) are skipped.
+func (c *Client) Inventory() (Inventory, error) {
+	items := make(map[string][]Instance)
+	for _, class := range InventoryClasses {
+		instances, err := c.Enumerate(ResourceURI(class))
+		if _, isFault := err.(*ErrSOAPFault); isFault {
+			if Verbose {
+				fmt.Printf("%s: skipping %s: %s\n", c.Hostname, class, err)
+			}
+			continue
+		}
+		if err != nil {
+			return Inventory{}, err
+		}
+		items[class] = instances
+	}
+	inventory := NewInventory(items)
+	inventory.Updated = int(time.Now().Unix())
+	return inventory, nil
+}
+
+// NewInventory summarizes items, as collected by Client.Inventory().
+func NewInventory(items map[string][]Instance) (inventory Inventory) {
+	inventory.Items = items
+	for _, instance := range items["CIM_ComputerSystemPackage"] {
+		inventory.PlatformGUID = instance.Get("PlatformGUID")
+	}
+	for _, instance := range items["CIM_Chassis"] {
+		inventory.Manufacturer = instance.Get("Manufacturer")
+		inventory.Model = instance.Get("Model")
+		inventory.SerialNumber = instance.Get("SerialNumber")
+	}
+	for _, instance := range items["CIM_BIOSElement"] {
+		inventory.BiosVendor = instance.Get("Manufacturer")
+		inventory.BiosVersion = instance.Get("Version")
+		inventory.BiosDate = instance.Get("ReleaseDate")
+	}
+	for _, instance := range items["CIM_Processor"] {
+		inventory.CPUCount++
+		if speed, _ := strconv.Atoi(instance.Get("MaxClockSpeed")); speed > inventory.CPUMaxSpeed {
+			inventory.CPUMaxSpeed = speed
+		}
+	}
+	for _, instance := range items["CIM_PhysicalMemory"] {
+		capacity, _ := strconv.ParseInt(instance.Get("Capacity"), 10, 64)
+		if capacity > 0 {
+			inventory.MemoryModules++
+			inventory.MemoryMB += int(capacity / (1024 * 1024))
+		}
+	}
+	for _, instance := range items["CIM_SoftwareIdentity"] {
+		if instance.Get("InstanceID") == "AMT" {
+			inventory.AmtVersion = instance.Get("VersionString")
+		}
+	}
+	return
+}
+
+// CliInventory collects and prints the inventory of a list of hosts.
+func CliInventory(hosts []string, format string, options Optionset) {
+	if len(hosts) == 0 {
+		fmt.Println("Error: Expected list of hostnames as arguments")
+		return
+	}
+	if format != FormatJSON && format != FormatTable {
+		fmt.Printf("Error: Unsupported output format %s\n", format)
+		return
+	}
+	options = cliOptions(options)
+
+	results := make(map[string]Inventory)
+	for _, host := range hosts {
+		inventory, err := NewClient(host, options).Inventory()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: Error: %s\n", host, err)
+			continue
+		}
+		results[host] = inventory
+	}
+
+	if format == FormatJSON {
+		data, _ := json.MarshalIndent(results, "", "  ")
+		fmt.Println(string(data))
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Host\tManufacturer\tModel\tSerial\tBIOS\tCPUs\tMHz\tMemory MB\tAMT")
+	for _, host := range hosts {
+		if i, ok := results[host]; ok {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%d\t%d\t%s\n", host, i.Manufacturer, i.Model,
+				i.SerialNumber, i.BiosVersion, i.CPUCount, i.CPUMaxSpeed, i.MemoryMB, i.AmtVersion)
+		}
+	}
+	w.Flush()
+}
@@ -0,0 +1,29 @@
+package amt
+
+import "testing"
+
+func TestNewInventory(t *testing.T) {
+	items, err := parseInstances([]byte(`<h:CIM_BIOSElement xmlns:h="` + uriCIM + `CIM_BIOSElement">` +
+		`<h:Manufacturer>Intel Corp.</h:Manufacturer><h:ReleaseDate><Datetime>2016-06-21T00:00:00Z</Datetime></h:ReleaseDate>` +
+		`<h:Version>RYBDWi35.86A.0359.2016.0906.1028</h:Version></h:CIM_BIOSElement>` +
+		`<h:CIM_Processor xmlns:h="` + uriCIM + `CIM_Processor"><h:MaxClockSpeed>3200</h:MaxClockSpeed></h:CIM_Processor>` +
+		`<h:CIM_SoftwareIdentity xmlns:h="` + uriCIM + `CIM_SoftwareIdentity"><h:InstanceID>Flash</h:InstanceID>` +
+		`<h:VersionString>11.0.0</h:VersionString></h:CIM_SoftwareIdentity>` +
+		`<h:CIM_SoftwareIdentity xmlns:h="` + uriCIM + `CIM_SoftwareIdentity"><h:InstanceID>AMT</h:InstanceID>` +
+		`<h:VersionString>11.6.27</h:VersionString></h:CIM_SoftwareIdentity>`))
+	if err != nil {
+		t.Fatalf("parseInstances failed: %s", err)
+	}
+	byClass := make(map[string][]Instance)
+	for _, item := range items {
+		byClass[item.Class] = append(byClass[item.Class], item)
+	}
+
+	inventory := NewInventory(byClass)
+	if inventory.BiosVendor != "Intel Corp." || inventory.BiosDate != "2016-06-21T00:00:00Z" {
+		t.Errorf("Unexpected BIOS inventory: %+v", inventory)
+	}
+	if inventory.CPUCount != 1 || inventory.CPUMaxSpeed != 3200 || inventory.AmtVersion != "11.6.27" {
+		t.Errorf("Unexpected CPU/AMT inventory: %+v", inventory)
+	}
+}
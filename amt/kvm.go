@@ -0,0 +1,313 @@
+package amt
+
+import (
+	"bytes"
+	"crypto/des"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"unicode"
+)
+
+// RFB protocol version spoken by the KVM proxy on both sides
+const rfbVersion = "RFB 003.008\n"
+
+// RFB security types
+const (
+	rfbSecurityNone = 1
+	rfbSecurityVNC  = 2
+)
+
+// KVM is a KVM redirection session. After Open, it carries the RFB (VNC)
+// protocol, starting with ClientInit sent by the viewer.
+type KVM struct {
+	*Redirection
+}
+
+// NewKVM returns a KVM session for hostname, using TLS settings and
+// credentials as given in options.
+func NewKVM(hostname string, options Optionset) *KVM {
+	return &KVM{Redirection: NewRedirection(hostname, options)}
+}
+
+// Open starts an authenticated KVM session and completes the RFB handshake
+// up to (excluding) ClientInit.
+func (k *KVM) Open() error {
+	if err := k.Redirection.Open(RedirectionKVM); err != nil {
+		return err
+	}
+	if err := k.handshake(); err != nil {
+		k.conn.Close()
+		return err
+	}
+	return nil
+}
+
+// handshake negotiates RFB 3.8 without security; AMT authenticated the session already.
+func (k *KVM) handshake() error {
+	version, err := k.read(12)
+	if err != nil {
+		return err
+	}
+	if !bytes.HasPrefix(version, []byte("RFB ")) {
+		return fmt.Errorf("unexpected KVM protocol version %q", version)
+	}
+	if err = k.send([]byte(rfbVersion)); err != nil {
+		return err
+	}
+	count, err := k.read(1)
+	if err != nil {
+		return err
+	}
+	if count[0] == 0 {
+		return fmt.Errorf("KVM connection refused by AMT")
+	}
+	types, err := k.read(int(count[0]))
+	if err != nil {
+		return err
+	}
+	if bytes.IndexByte(types, rfbSecurityNone) < 0 {
+		return fmt.Errorf("KVM security types %v not supported", types)
+	}
+	if err = k.send([]byte{rfbSecurityNone}); err != nil {
+		return err
+	}
+	result, err := k.read(4)
+	if err != nil {
+		return err
+	}
+	if binary.BigEndian.Uint32(result) != 0 {
+		return fmt.Errorf("KVM security handshake failed")
+	}
+	return nil
+}
+
+// Read reads RFB data sent by AMT.
+func (k *KVM) Read(p []byte) (int, error) {
+	return k.reader.Read(p)
+}
+
+// Write sends RFB data to AMT.
+func (k *KVM) Write(p []byte) (int, error) {
+	if err := k.send(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Close closes the KVM session. There is no redirection end message,
+// as the connection carries RFB only.
+func (k *KVM) Close() error {
+	if k.conn == nil {
+		return nil
+	}
+	return k.conn.Close()
+}
+
+// KVMProxy serves AMT KVM of a host as plain VNC server.
+type KVMProxy struct {
+	Hostname string
+	Address  string // AMT redirection host:port, if not default
+	Password string // VNC password required from viewers, none if empty
+	options  Optionset
+}
+
+// NewKVMProxy returns a KVMProxy for hostname, using TLS settings and
+// credentials as given in options.
+func NewKVMProxy(hostname string, password string, options Optionset) *KVMProxy {
+	return &KVMProxy{Hostname: hostname, Password: password, options: options}
+}
+
+// Serve accepts VNC viewer connections on listener, opening a KVM
+// session for each one. It returns when listener fails.
+func (p *KVMProxy) Serve(listener net.Listener) error {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+		go func() {
+			if err := p.handle(conn); err != nil {
+				log.Printf("KVM proxy %s: %s", conn.RemoteAddr(), err)
+			}
+		}()
+	}
+}
+
+func (p *KVMProxy) handle(viewer net.Conn) error {
+	defer viewer.Close()
+	if err := p.handshake(viewer); err != nil {
+		return err
+	}
+	kvm := NewKVM(p.Hostname, p.options)
+	if p.Address != "" {
+		kvm.Address = p.Address
+	}
+	if err := kvm.Open(); err != nil {
+		rfbSecurityResult(viewer, err.Error())
+		return err
+	}
+	defer kvm.Close()
+	if err := rfbSecurityResult(viewer, ""); err != nil {
+		return err
+	}
+	if Verbose {
+		log.Printf("KVM proxy %s: connected to %s", viewer.RemoteAddr(), p.Hostname)
+	}
+
+	done := make(chan error, 2)
+	go func() {
+		_, err := io.Copy(kvm, viewer)
+		done <- err
+	}()
+	go func() {
+		_, err := io.Copy(viewer, kvm)
+		done <- err
+	}()
+	return <-done
+}
+
+// handshake negotiates RFB 3.8 with a viewer, authenticating it if
+// Password is set. The security result is sent after the KVM session is open.
+func (p *KVMProxy) handshake(viewer net.Conn) error {
+	if _, err := viewer.Write([]byte(rfbVersion)); err != nil {
+		return err
+	}
+	version := make([]byte, 12)
+	if _, err := io.ReadFull(viewer, version); err != nil {
+		return err
+	}
+	if string(version) != rfbVersion {
+		return fmt.Errorf("unsupported viewer protocol version %q", version)
+	}
+	security := byte(rfbSecurityNone)
+	if p.Password != "" {
+		security = rfbSecurityVNC
+	}
+	if _, err := viewer.Write([]byte{1, security}); err != nil {
+		return err
+	}
+	selected := make([]byte, 1)
+	if _, err := io.ReadFull(viewer, selected); err != nil {
+		return err
+	}
+	if selected[0] != security {
+		return fmt.Errorf("viewer selected unsupported security type %d", selected[0])
+	}
+	if security == rfbSecurityNone {
+		return nil
+	}
+
+	challenge := make([]byte, 16)
+	rand.Read(challenge)
+	if _, err := viewer.Write(challenge); err != nil {
+		return err
+	}
+	response := make([]byte, 16)
+	if _, err := io.ReadFull(viewer, response); err != nil {
+		return err
+	}
+	if !bytes.Equal(response, vncAuthResponse(p.Password, challenge)) {
+		rfbSecurityResult(viewer, "Authentication failed")
+		return fmt.Errorf("viewer authentication failed")
+	}
+	return nil
+}
+
+// rfbSecurityResult sends RFB 3.8 SecurityResult; failed if reason is non-empty.
+func rfbSecurityResult(viewer net.Conn, reason string) error {
+	if reason == "" {
+		_, err := viewer.Write([]byte{0, 0, 0, 0})
+		return err
+	}
+	message := []byte{0, 0, 0, 1, 0, 0, 0, 0}
+	binary.BigEndian.PutUint32(message[4:], uint32(len(reason)))
+	_, err := viewer.Write(append(message, reason...))
+	return err
+}
+
+// vncAuthResponse encrypts challenge using DES with password as key,
+// bits of each key byte mirrored as required by VNC authentication.
+func vncAuthResponse(password string, challenge []byte) []byte {
+	key := make([]byte, 8)
+	copy(key, password)
+	for i, b := range key {
+		var mirrored byte
+		for bit := uint(0); bit < 8; bit++ {
+			if b&(1<<bit) != 0 {
+				mirrored |= 0x80 >> bit
+			}
+		}
+		key[i] = mirrored
+	}
+	cipher, _ := des.NewCipher(key)
+	response := make([]byte, len(challenge))
+	for i := 0; i+8 <= len(challenge); i += 8 {
+		cipher.Encrypt(response[i:i+8], challenge[i:i+8])
+	}
+	return response
+}
+
+// SetRFBPassword sets the password of the KVM VNC port 5900 and enables it.
+func (c *Client) SetRFBPassword(password string) error {
+	if err := validRFBPassword(password); err != nil {
+		return err
+	}
+	return c.modify(ipsKVMRedirectionSettingData, nil,
+		Property{Name: "RFBPassword", Value: password},
+		Property{Name: "Is5900PortEnabled", Value: "true"})
+}
+
+// validRFBPassword checks AMT's RFB password rules: 8 characters,
+// including upper and lower case letters, digits and special characters.
+func validRFBPassword(password string) error {
+	var upper, lower, digit, special bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			special = true
+		}
+	}
+	if len(password) != 8 || !upper || !lower || !digit || !special {
+		return fmt.Errorf("RFB password must have 8 characters, including upper and lower case letters, digits and special characters")
+	}
+	return nil
+}
+
+// CliKvmPassword sets the RFB password of a list of hosts.
+func CliKvmPassword(password string, hosts []string, options Optionset) {
+	if err := validRFBPassword(password); err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+	options.RFBPassword = password
+	CliCommand(CmdKvmPassword, hosts, options)
+}
+
+// CliKVMProxy serves AMT KVM of a host as VNC server on listen address.
+func CliKVMProxy(hosts []string, listen string, password string, options Optionset) {
+	if len(hosts) != 1 {
+		fmt.Println("Error: Expected a single hostname as argument")
+		return
+	}
+	options = cliOptions(options)
+
+	listener, err := net.Listen("tcp", listen)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+	fmt.Printf("Serving KVM of %s as VNC server on %s\n", hosts[0], listener.Addr())
+	if err = NewKVMProxy(hosts[0], password, options).Serve(listener); err != nil {
+		fmt.Printf("Error: %s\n", err)
+	}
+}
@@ -0,0 +1,65 @@
+package amt
+
+import (
+	"io"
+	"net"
+	"testing"
+)
+
+func TestKVMProxy(t *testing.T) {
+	amtListener := fakeRedirection(t, RedirectionKVM, func(conn net.Conn) {
+		conn.Write([]byte("RFB 004.000\n"))
+		version := make([]byte, 12)
+		io.ReadFull(conn, version)
+		if string(version) != rfbVersion {
+			t.Errorf("Unexpected RFB version %q", version)
+		}
+		conn.Write([]byte{1, rfbSecurityNone})
+		selected := make([]byte, 1)
+		io.ReadFull(conn, selected)
+		conn.Write([]byte{0, 0, 0, 0})
+		clientInit := make([]byte, 1)
+		io.ReadFull(conn, clientInit)
+		conn.Write([]byte("ServerInit"))
+	})
+	defer amtListener.Close()
+
+	proxy := NewKVMProxy("localhost", "vnc", Optionset{OptTimeout: 5, Username: "admin", Password: "secret"})
+	proxy.Address = amtListener.Addr().String()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("cannot listen: %s", err)
+	}
+	defer listener.Close()
+	go proxy.Serve(listener)
+
+	viewer, err := net.Dial("tcp", listener.Addr().String())
+	if err != nil {
+		t.Fatalf("cannot connect to proxy: %s", err)
+	}
+	defer viewer.Close()
+	read := func(n int) []byte {
+		data := make([]byte, n)
+		if _, err := io.ReadFull(viewer, data); err != nil {
+			t.Fatalf("viewer read failed: %s", err)
+		}
+		return data
+	}
+
+	if version := read(12); string(version) != rfbVersion {
+		t.Errorf("Unexpected proxy RFB version %q", version)
+	}
+	viewer.Write([]byte(rfbVersion))
+	if security := read(2); security[1] != rfbSecurityVNC {
+		t.Errorf("Unexpected proxy security types %v", security)
+	}
+	viewer.Write([]byte{rfbSecurityVNC})
+	viewer.Write(vncAuthResponse("vnc", read(16)))
+	if result := read(4); result[3] != 0 {
+		t.Fatalf("VNC authentication failed: %v", result)
+	}
+	viewer.Write([]byte{1}) // ClientInit, shared
+	if init := read(10); string(init) != "ServerInit" {
+		t.Errorf("Unexpected ServerInit %q", init)
+	}
+}
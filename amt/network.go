@@ -0,0 +1,213 @@
+package amt
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// InstanceID of the wired AMT interface
+const wiredPortSettingsID = "Intel(r) AMT Ethernet Port Settings 0"
+
+// Network configuration modes of NetworkConfig
+const (
+	NetworkDHCP   = "dhcp"
+	NetworkShared = "shared" // static IP, shared with the OS
+	NetworkStatic = "static"
+)
+
+// NetworkSettings of an AMT network interface, from AMT_EthernetPortSettings
+type NetworkSettings struct {
+	InstanceID     string `json:"instance_id"`
+	MACAddress     string `json:"mac_address"`
+	LinkIsUp       bool   `json:"link_is_up"`
+	DHCPEnabled    bool   `json:"dhcp_enabled"`
+	IPSyncEnabled  bool   `json:"ip_sync_enabled"`
+	SharedStaticIP bool   `json:"shared_static_ip"`
+	IPAddress      string `json:"ip_address"`
+	SubnetMask     string `json:"subnet_mask"`
+	DefaultGateway string `json:"default_gateway"`
+	PrimaryDNS     string `json:"primary_dns"`
+	SecondaryDNS   string `json:"secondary_dns"`
+	LinkPolicy     []int  `json:"link_policy"`
+}
+
+// NetworkConfig is the network configuration set on the wired AMT interface.
+type NetworkConfig struct {
+	Mode           string
+	IPAddress      string
+	SubnetMask     string
+	DefaultGateway string
+	PrimaryDNS     string
+	SecondaryDNS   string
+}
+
+// link policy bits of AMT_EthernetPortSettings.LinkPolicy values
+var linkPolicyTextMap = map[int]string{
+	1:   "S0-AC",
+	14:  "Sx-AC",
+	16:  "S0-DC",
+	224: "Sx-DC",
+}
+
+// newNetworkSettings converts an AMT_EthernetPortSettings instance.
+func newNetworkSettings(instance Instance) NetworkSettings {
+	settings := NetworkSettings{
+		InstanceID:     instance.Get("InstanceID"),
+		MACAddress:     instance.Get("MACAddress"),
+		LinkIsUp:       instance.Get("LinkIsUp") == "true",
+		DHCPEnabled:    instance.Get("DHCPEnabled") == "true",
+		IPSyncEnabled:  instance.Get("IpSyncEnabled") == "true",
+		SharedStaticIP: instance.Get("SharedStaticIp") == "true",
+		IPAddress:      instance.Get("IPAddress"),
+		SubnetMask:     instance.Get("SubnetMask"),
+		DefaultGateway: instance.Get("DefaultGateway"),
+		PrimaryDNS:     instance.Get("PrimaryDNS"),
+		SecondaryDNS:   instance.Get("SecondaryDNS"),
+	}
+	for _, value := range instance.Values("LinkPolicy") {
+		if policy, err := strconv.Atoi(value); err == nil {
+			settings.LinkPolicy = append(settings.LinkPolicy, policy)
+		}
+	}
+	return settings
+}
+
+// Mode returns the configuration mode of the interface.
+func (s NetworkSettings) Mode() string {
+	if s.DHCPEnabled {
+		return NetworkDHCP
+	}
+	if s.IPSyncEnabled {
+		return NetworkShared
+	}
+	return NetworkStatic
+}
+
+// LinkPolicyText returns the interface's link policies as text.
+func (s NetworkSettings) LinkPolicyText() string {
+	var policies []string
+	for _, policy := range s.LinkPolicy {
+		text, ok := linkPolicyTextMap[policy]
+		if !ok {
+			text = strconv.Itoa(policy)
+		}
+		policies = append(policies, text)
+	}
+	return strings.Join(policies, ",")
+}
+
+// NetworkSettings returns the settings of all AMT network interfaces.
+func (c *Client) NetworkSettings() ([]NetworkSettings, error) {
+	instances, err := c.Enumerate(amtEthernetPortSettings)
+	if err != nil {
+		return nil, err
+	}
+	var settings []NetworkSettings
+	for _, instance := range instances {
+		settings = append(settings, newNetworkSettings(instance))
+	}
+	return settings, nil
+}
+
+// Validate checks a network configuration for missing or invalid addresses.
+func (n NetworkConfig) Validate() error {
+	switch n.Mode {
+	case NetworkDHCP, NetworkShared:
+		return nil
+	case NetworkStatic:
+		if n.IPAddress == "" || n.SubnetMask == "" {
+			return fmt.Errorf("static network configuration requires IP address and subnet mask")
+		}
+		for _, address := range []string{n.IPAddress, n.SubnetMask, n.DefaultGateway, n.PrimaryDNS, n.SecondaryDNS} {
+			if address != "" && net.ParseIP(address).To4() == nil {
+				return fmt.Errorf("invalid IPv4 address '%s'", address)
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported network configuration mode '%s'", n.Mode)
+}
+
+// SetNetwork configures the wired AMT interface.
+func (c *Client) SetNetwork(n NetworkConfig) error {
+	if err := n.Validate(); err != nil {
+		return err
+	}
+	properties := []Property{
+		{Name: "DHCPEnabled", Value: strconv.FormatBool(n.Mode == NetworkDHCP)},
+	}
+	if n.Mode != NetworkDHCP {
+		shared := strconv.FormatBool(n.Mode == NetworkShared)
+		properties = append(properties,
+			Property{Name: "IpSyncEnabled", Value: shared},
+			Property{Name: "SharedStaticIp", Value: shared})
+	}
+	if n.Mode == NetworkStatic {
+		properties = append(properties,
+			Property{Name: "IPAddress", Value: n.IPAddress},
+			Property{Name: "SubnetMask", Value: n.SubnetMask},
+			Property{Name: "DefaultGateway", Value: n.DefaultGateway},
+			Property{Name: "PrimaryDNS", Value: n.PrimaryDNS},
+			Property{Name: "SecondaryDNS", Value: n.SecondaryDNS})
+	}
+	return c.modify(amtEthernetPortSettings, []Selector{{"InstanceID", wiredPortSettingsID}}, properties...)
+}
+
+// CliNetworkShow prints the network settings of a list of hosts.
+func CliNetworkShow(hosts []string, format string, options Optionset) {
+	if len(hosts) == 0 {
+		fmt.Println("Error: Expected list of hostnames as arguments")
+		return
+	}
+	if format != FormatJSON && format != FormatTable {
+		fmt.Printf("Error: Unsupported output format %s\n", format)
+		return
+	}
+	options = cliOptions(options)
+
+	results := make(map[string][]NetworkSettings)
+	for _, host := range hosts {
+		settings, err := NewClient(host, options).NetworkSettings()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: Error: %s\n", host, err)
+			continue
+		}
+		results[host] = settings
+	}
+
+	if format == FormatJSON {
+		data, _ := json.MarshalIndent(results, "", "  ")
+		fmt.Println(string(data))
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Host\tInterface\tMAC\tLink\tMode\tIP\tMask\tGateway\tDNS\tLink policy")
+	for _, host := range hosts {
+		for _, s := range results[host] {
+			link := "down"
+			if s.LinkIsUp {
+				link = "up"
+			}
+			dns := strings.Trim(s.PrimaryDNS+","+s.SecondaryDNS, ",")
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", host,
+				strings.TrimPrefix(s.InstanceID, "Intel(r) AMT Ethernet Port Settings "), s.MACAddress, link,
+				s.Mode(), s.IPAddress, s.SubnetMask, s.DefaultGateway, dns, s.LinkPolicyText())
+		}
+	}
+	w.Flush()
+}
+
+// CliNetworkSet configures the wired AMT interface of a list of hosts.
+func CliNetworkSet(config NetworkConfig, hosts []string, options Optionset) {
+	if err := config.Validate(); err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+	options.Network = config
+	CliCommand(CmdNetworkSet, hosts, options)
+}
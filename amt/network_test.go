@@ -0,0 +1,56 @@
+package amt
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNetworkSettings(t *testing.T) {
+	settings := newNetworkSettings(Instance{Class: "AMT_EthernetPortSettings", Properties: []Property{
+		{Name: "InstanceID", Value: wiredPortSettingsID},
+		{Name: "MACAddress", Value: "00-1a-2b-3c-4d-5e"},
+		{Name: "DHCPEnabled", Value: "false"},
+		{Name: "IpSyncEnabled", Value: "true"},
+		{Name: "IPAddress", Value: "192.168.1.10"},
+		{Name: "LinkPolicy", Value: "1"},
+		{Name: "LinkPolicy", Value: "14"},
+	}})
+	if settings.Mode() != NetworkShared || settings.IPAddress != "192.168.1.10" || settings.LinkPolicyText() != "S0-AC,Sx-AC" {
+		t.Errorf("Unexpected network settings: %+v", settings)
+	}
+}
+
+func TestSetNetwork(t *testing.T) {
+	server, client := fakeAMT(t, func(action string, envelope string) (int, string) {
+		switch action {
+		case actionGet:
+			return 200, testResponse(action+"Response", `<g:AMT_EthernetPortSettings xmlns:g="`+amtEthernetPortSettings+`">`+
+				`<g:DHCPEnabled>true</g:DHCPEnabled><g:ElementName>Intel(r) AMT Ethernet Port Settings</g:ElementName>`+
+				`<g:InstanceID>`+wiredPortSettingsID+`</g:InstanceID><g:IpSyncEnabled>true</g:IpSyncEnabled>`+
+				`<g:MACAddress>00-1a-2b-3c-4d-5e</g:MACAddress></g:AMT_EthernetPortSettings>`)
+		case actionPut:
+			for _, expected := range []string{"<h:DHCPEnabled>false</h:DHCPEnabled>",
+				"<h:IpSyncEnabled>false</h:IpSyncEnabled>",
+				"<h:IPAddress>192.168.1.10</h:IPAddress>",
+				"<h:SubnetMask>255.255.255.0</h:SubnetMask>",
+				"<h:MACAddress>00-1a-2b-3c-4d-5e</h:MACAddress>",
+				`<wsman:Selector Name="InstanceID">` + wiredPortSettingsID + `</wsman:Selector>`} {
+				if !strings.Contains(envelope, expected) {
+					t.Errorf("Put lacks %s:\n%s", expected, envelope)
+				}
+			}
+			return 200, testResponse(action+"Response", "")
+		}
+		t.Errorf("Unexpected action %s", action)
+		return 400, ""
+	})
+	defer server.Close()
+
+	if err := client.SetNetwork(NetworkConfig{Mode: NetworkStatic, IPAddress: "192.168.1.10"}); err == nil {
+		t.Error("SetNetwork accepted static configuration without subnet mask")
+	}
+	config := NetworkConfig{Mode: NetworkStatic, IPAddress: "192.168.1.10", SubnetMask: "255.255.255.0"}
+	if err := client.SetNetwork(config); err != nil {
+		t.Errorf("SetNetwork failed: %s", err)
+	}
+}
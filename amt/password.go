@@ -0,0 +1,86 @@
+package amt
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"unicode"
+)
+
+// CheckAdminPassword checks AMT's admin password rules: 8 to 32 characters,
+// including upper and lower case letters, digits and special characters
+// other than quotes, commas and colons.
+func CheckAdminPassword(password string) error {
+	var upper, lower, digit, special bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			special = true
+		}
+	}
+	if len(password) < 8 || len(password) > 32 || !upper || !lower || !digit || !special {
+		return fmt.Errorf("AMT password must have 8 to 32 characters, including upper and lower case letters, digits and special characters")
+	}
+	if strings.ContainsAny(password, `",:`) {
+		return fmt.Errorf("AMT password must not contain quotes, commas or colons")
+	}
+	return nil
+}
+
+// ReadPasswordFile reads a password from filename, ignoring surrounding whitespace.
+func ReadPasswordFile(filename string) (string, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// SetAdminPassword sets the password of the AMT admin user.
+func (c *Client) SetAdminPassword(password string) error {
+	if err := CheckAdminPassword(password); err != nil {
+		return err
+	}
+	var acl struct{ Username string }
+	if err := c.Invoke(amtAuthorizationService, "GetAdminAclEntry", nil, &acl); err != nil {
+		return err
+	}
+	if acl.Username == "" {
+		return fmt.Errorf("could not determine admin username")
+	}
+	digest, err := c.digestPassword(acl.Username, password)
+	if err != nil {
+		return err
+	}
+	var output struct{ ReturnValue int }
+	err = c.Invoke(amtAuthorizationService, "SetAdminAclEntryEx", nil, &output,
+		Property{Name: "Username", Value: acl.Username},
+		Property{Name: "DigestPassword", Value: digest})
+	if err != nil {
+		return err
+	}
+	return checkReturnValue("SetAdminAclEntryEx", output.ReturnValue)
+}
+
+// digestPassword returns the base64 encoded HTTP digest hash of
+// username and password in the host's digest realm, as expected by
+// AMT_AuthorizationService.
+func (c *Client) digestPassword(username string, password string) (string, error) {
+	var settings struct{ DigestRealm string }
+	if err := c.Get(amtGeneralSettings, nil, &settings); err != nil {
+		return "", err
+	}
+	if settings.DigestRealm == "" {
+		return "", fmt.Errorf("could not determine digest realm")
+	}
+	digest := md5.Sum([]byte(username + ":" + settings.DigestRealm + ":" + password))
+	return base64.StdEncoding.EncodeToString(digest[:]), nil
+}
@@ -0,0 +1,43 @@
+package amt
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSetAdminPassword(t *testing.T) {
+	var actions []string
+	server, client := fakeAMT(t, func(action string, envelope string) (int, string) {
+		actions = append(actions, className(action))
+		switch action {
+		case actionGet:
+			return 200, testResponse(action+"Response", `<g:AMT_GeneralSettings xmlns:g="`+amtGeneralSettings+`">`+
+				`<g:DigestRealm>Digest:A3829B3827DE4D33D4449B366831FD01</g:DigestRealm></g:AMT_GeneralSettings>`)
+		case amtAuthorizationService + "/GetAdminAclEntry":
+			return 200, testResponse(action+"Response", `<h:GetAdminAclEntry_OUTPUT><h:Username>admin</h:Username>`+
+				`<h:ReturnValue>0</h:ReturnValue></h:GetAdminAclEntry_OUTPUT>`)
+		case amtAuthorizationService + "/SetAdminAclEntryEx":
+			// base64 of md5("admin:Digest:A3829B3827DE4D33D4449B366831FD01:Pa$$w0rd!")
+			for _, expected := range []string{"<h:Username>admin</h:Username>", "<h:DigestPassword>GMS0ySUtaYYc+jiLpMkNPA==</h:DigestPassword>"} {
+				if !strings.Contains(envelope, expected) {
+					t.Errorf("SetAdminAclEntryEx lacks %s:\n%s", expected, envelope)
+				}
+			}
+			return 200, testResponse(action+"Response", `<h:SetAdminAclEntryEx_OUTPUT>`+
+				`<h:ReturnValue>0</h:ReturnValue></h:SetAdminAclEntryEx_OUTPUT>`)
+		}
+		t.Errorf("Unexpected action %s", action)
+		return 400, ""
+	})
+	defer server.Close()
+
+	if err := client.SetAdminPassword("password"); err == nil {
+		t.Error("SetAdminPassword accepted weak password")
+	}
+	if err := client.SetAdminPassword("Pa$$w0rd!"); err != nil {
+		t.Fatalf("SetAdminPassword failed: %s", err)
+	}
+	if strings.Join(actions, ",") != "GetAdminAclEntry,Get,SetAdminAclEntryEx" {
+		t.Errorf("Unexpected actions %v", actions)
+	}
+}
@@ -0,0 +1,243 @@
+package amt
+
+import (
+	"bufio"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	dac "github.com/schnoddelbotz/amtgo/amt/digest_auth_client"
+)
+
+// AMT redirection protocol commands
+const (
+	redirStartSession      = 0x10
+	redirStartSessionReply = 0x11
+	redirEndSession        = 0x12
+	redirAuthenticate      = 0x13
+	redirAuthenticateReply = 0x14
+)
+
+// redirection authentication types
+const (
+	redirAuthQuery  = 0
+	redirAuthDigest = 4
+)
+
+const redirStatusSuccess = 0
+
+// URI used for digest authentication of redirection sessions
+const redirAuthURI = "/RedirectionService"
+
+// Redirection session types
+const (
+	RedirectionSOL  = "SOL "
+	RedirectionIDER = "IDER"
+	RedirectionKVM  = "KVMR"
+)
+
+// Redirection is a session using the AMT redirection protocol (SOL, IDER, KVM).
+type Redirection struct {
+	Hostname string
+	Address  string // host:port of AMT redirection service
+	options  Optionset
+	conn     net.Conn
+	reader   *bufio.Reader
+	mutex    sync.Mutex // serializes writes / sequence numbers
+	sequence uint32
+}
+
+// NewRedirection returns a Redirection for hostname, using TLS settings and
+// credentials as given in options.
+func NewRedirection(hostname string, options Optionset) *Redirection {
+	port := "16994"
+	if options.SwUseTLS == 1 {
+		port = "16995"
+	}
+	return &Redirection{
+		Hostname: hostname,
+		Address:  net.JoinHostPort(hostname, port),
+		options:  options,
+	}
+}
+
+// Open connects to the host, starts a redirection session of given type
+// and authenticates using HTTP digest.
+func (r *Redirection) Open(session string) (err error) {
+	timeout := time.Duration(r.options.OptTimeout) * time.Second
+	dialer := &net.Dialer{Timeout: timeout}
+	if r.options.SwUseTLS == 1 {
+		config := &tls.Config{InsecureSkipVerify: true}
+		if r.options.SwSkipcertchk != 1 && len(r.options.CaCertData) > 0 {
+			roots := x509.NewCertPool()
+			if !roots.AppendCertsFromPEM(r.options.CaCertData) {
+				return &ErrTLSVerify{dac.ErrInvalidCACert}
+			}
+			config = &tls.Config{RootCAs: roots, ServerName: r.Hostname}
+		}
+		r.conn, err = tls.DialWithDialer(dialer, "tcp", r.Address, config)
+	} else {
+		r.conn, err = dialer.Dial("tcp", r.Address)
+	}
+	if err != nil {
+		return transportError(err)
+	}
+	r.reader = bufio.NewReader(r.conn)
+	if timeout > 0 {
+		r.conn.SetDeadline(time.Now().Add(timeout))
+	}
+	if err = r.startSession(session); err == nil {
+		err = r.authenticate()
+	}
+	if err != nil {
+		r.conn.Close()
+		return err
+	}
+	r.conn.SetDeadline(time.Time{})
+	return nil
+}
+
+// Close ends the redirection session and closes the connection.
+func (r *Redirection) Close() error {
+	if r.conn == nil {
+		return nil
+	}
+	r.send([]byte{redirEndSession, 0, 0, 0})
+	return r.conn.Close()
+}
+
+func (r *Redirection) startSession(session string) error {
+	if err := r.send(append([]byte{redirStartSession, 0, 0, 0}, session...)); err != nil {
+		return err
+	}
+	reply, err := r.read(13)
+	if err != nil {
+		return err
+	}
+	if reply[0] != redirStartSessionReply || reply[1] != redirStatusSuccess {
+		return fmt.Errorf("redirection session %q refused (command 0x%02x, status %d)", session, reply[0], reply[1])
+	}
+	_, err = r.read(int(reply[12])) // OEM defined data
+	return err
+}
+
+func (r *Redirection) authenticate() error {
+	user := r.options.Username
+	if err := r.sendAuthentication(user, "", "", redirAuthURI, "", "", "", ""); err != nil {
+		return err
+	}
+	status, data, err := r.readAuthenticateReply()
+	if err != nil {
+		return err
+	}
+	if status == redirStatusSuccess {
+		return nil
+	}
+
+	// digest challenge: realm, nonce, qop
+	var challenge []string
+	for len(data) > 0 && len(challenge) < 3 {
+		n := int(data[0])
+		if len(data) < 1+n {
+			return fmt.Errorf("invalid redirection digest challenge")
+		}
+		challenge = append(challenge, string(data[1:1+n]))
+		data = data[1+n:]
+	}
+	if len(challenge) < 2 {
+		return fmt.Errorf("invalid redirection digest challenge")
+	}
+	challenge = append(challenge, "")
+	realm, nonce, qop := challenge[0], challenge[1], challenge[2]
+
+	cnonce := make([]byte, 16)
+	rand.Read(cnonce)
+	nc := "00000002"
+	response := redirDigestResponse(user, r.options.Password, realm, nonce, nc, hex.EncodeToString(cnonce), qop)
+	err = r.sendAuthentication(user, realm, nonce, redirAuthURI, hex.EncodeToString(cnonce), nc, response, qop)
+	if err != nil {
+		return err
+	}
+	if status, _, err = r.readAuthenticateReply(); err != nil {
+		return err
+	}
+	if status != redirStatusSuccess {
+		return fmt.Errorf("redirection authentication failed (status %d)", status)
+	}
+	return nil
+}
+
+// redirDigestResponse computes the digest response as expected by AMT.
+func redirDigestResponse(user, password, realm, nonce, nc, cnonce, qop string) string {
+	md5hex := func(s string) string {
+		sum := md5.Sum([]byte(s))
+		return hex.EncodeToString(sum[:])
+	}
+	ha1 := md5hex(user + ":" + realm + ":" + password)
+	ha2 := md5hex("POST:" + redirAuthURI)
+	if qop == "" {
+		return md5hex(ha1 + ":" + nonce + ":" + ha2)
+	}
+	return md5hex(ha1 + ":" + nonce + ":" + nc + ":" + cnonce + ":" + qop + ":" + ha2)
+}
+
+// sendAuthentication sends a digest authentication message of
+// length-prefixed user, realm, nonce, uri, cnonce, nc, response and qop.
+func (r *Redirection) sendAuthentication(fields ...string) error {
+	var data []byte
+	for _, f := range fields {
+		data = append(data, byte(len(f)))
+		data = append(data, f...)
+	}
+	message := []byte{redirAuthenticate, 0, 0, 0, redirAuthDigest, 0, 0, 0, 0}
+	binary.LittleEndian.PutUint32(message[5:9], uint32(len(data)))
+	return r.send(append(message, data...))
+}
+
+// readAuthenticateReply returns status and authentication data of the reply.
+func (r *Redirection) readAuthenticateReply() (int, []byte, error) {
+	reply, err := r.read(9)
+	if err != nil {
+		return 0, nil, err
+	}
+	if reply[0] != redirAuthenticateReply {
+		return 0, nil, fmt.Errorf("unexpected redirection command 0x%02x", reply[0])
+	}
+	if reply[4] != redirAuthDigest && reply[4] != redirAuthQuery {
+		return 0, nil, fmt.Errorf("unsupported redirection authentication type %d", reply[4])
+	}
+	data, err := r.read(int(binary.LittleEndian.Uint32(reply[5:9])))
+	return int(reply[1]), data, err
+}
+
+func (r *Redirection) send(message []byte) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	_, err := r.conn.Write(message)
+	return err
+}
+
+// sendSequenced sends command, 3 reserved bytes, a sequence number and data.
+func (r *Redirection) sendSequenced(command byte, data []byte) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	message := []byte{command, 0, 0, 0, 0, 0, 0, 0}
+	binary.LittleEndian.PutUint32(message[4:8], r.sequence)
+	r.sequence++
+	_, err := r.conn.Write(append(message, data...))
+	return err
+}
+
+func (r *Redirection) read(n int) ([]byte, error) {
+	data := make([]byte, n)
+	_, err := io.ReadFull(r.reader, data)
+	return data, err
+}
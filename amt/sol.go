@@ -0,0 +1,206 @@
+package amt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// Serial-over-LAN redirection commands
+const (
+	solStart            = 0x20
+	solStartReply       = 0x21
+	solEnd              = 0x22
+	solKeepAlivePing    = 0x24
+	solKeepAlivePong    = 0x25
+	solDataToHost       = 0x28
+	solControlsFromHost = 0x29
+	solDataFromHost     = 0x2A
+	solHeartbeat        = 0x2B
+)
+
+// maximum number of bytes sent in a single solDataToHost message
+const solMaxTxBuffer = 10000
+
+// solKeepAliveInterval is the interval of keepalive pings sent to AMT
+const solKeepAliveInterval = 10 * time.Second
+
+// solEscape (^]) ends interactive SOL sessions
+const solEscape = 0x1d
+
+// SOL is a serial-over-LAN session. Read returns data sent by the
+// host's serial console, Write sends data to it.
+type SOL struct {
+	*Redirection
+	pending []byte
+	done    chan bool
+}
+
+// NewSOL returns a SOL session for hostname, using TLS settings and
+// credentials as given in options.
+func NewSOL(hostname string, options Optionset) *SOL {
+	return &SOL{Redirection: NewRedirection(hostname, options)}
+}
+
+// Open starts an authenticated SOL session and keeps it alive until Close.
+func (s *SOL) Open() error {
+	if err := s.Redirection.Open(RedirectionSOL); err != nil {
+		return err
+	}
+	settings := make([]byte, 16)
+	binary.LittleEndian.PutUint16(settings[0:2], solMaxTxBuffer) // MaxTxBuffer
+	binary.LittleEndian.PutUint16(settings[2:4], 100)            // TxTimeout
+	binary.LittleEndian.PutUint16(settings[6:8], 10000)          // RxTimeout
+	binary.LittleEndian.PutUint16(settings[8:10], 100)           // RxFlushTimeout
+	err := s.sendSequenced(solStart, settings)
+	if err == nil {
+		var reply []byte
+		if reply, err = s.read(23); err == nil && (reply[0] != solStartReply || reply[1] != redirStatusSuccess) {
+			err = fmt.Errorf("SOL start refused (command 0x%02x, status %d)", reply[0], reply[1])
+		}
+	}
+	if err != nil {
+		s.Redirection.Close()
+		return err
+	}
+
+	done := make(chan bool)
+	s.done = done
+	go func() {
+		ticker := time.NewTicker(solKeepAliveInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.sendSequenced(solKeepAlivePing, nil)
+			case <-done:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Read reads serial console output of the host.
+func (s *SOL) Read(p []byte) (int, error) {
+	for len(s.pending) == 0 {
+		command, err := s.reader.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		switch command {
+		case solDataFromHost:
+			header, err := s.read(9)
+			if err != nil {
+				return 0, err
+			}
+			if s.pending, err = s.read(int(binary.LittleEndian.Uint16(header[7:9]))); err != nil {
+				return 0, err
+			}
+		case solHeartbeat, solKeepAlivePong:
+			if _, err = s.read(7); err != nil {
+				return 0, err
+			}
+		case solControlsFromHost:
+			if _, err = s.read(9); err != nil {
+				return 0, err
+			}
+		case solEnd, redirEndSession:
+			return 0, io.EOF
+		default:
+			return 0, fmt.Errorf("unexpected SOL command 0x%02x", command)
+		}
+	}
+	n := copy(p, s.pending)
+	s.pending = s.pending[n:]
+	return n, nil
+}
+
+// Write sends p to the host's serial console.
+func (s *SOL) Write(p []byte) (int, error) {
+	written := 0
+	for written < len(p) {
+		chunk := p[written:]
+		if len(chunk) > solMaxTxBuffer {
+			chunk = chunk[:solMaxTxBuffer]
+		}
+		length := make([]byte, 2)
+		binary.LittleEndian.PutUint16(length, uint16(len(chunk)))
+		if err := s.sendSequenced(solDataToHost, append(length, chunk...)); err != nil {
+			return written, err
+		}
+		written += len(chunk)
+	}
+	return written, nil
+}
+
+// Close ends the SOL session.
+func (s *SOL) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+	if s.done != nil {
+		close(s.done)
+		s.done = nil
+	}
+	s.sendSequenced(solEnd, nil)
+	return s.Redirection.Close()
+}
+
+// CliSol connects the local terminal to the serial console of a host.
+func CliSol(hosts []string, options Optionset) {
+	if len(hosts) != 1 {
+		fmt.Println("Error: Expected a single hostname as argument")
+		return
+	}
+	options = cliOptions(options)
+
+	sol := NewSOL(hosts[0], options)
+	if err := sol.Open(); err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+	defer sol.Close()
+
+	fd := int(os.Stdin.Fd())
+	if terminal.IsTerminal(fd) {
+		if state, err := terminal.MakeRaw(fd); err == nil {
+			defer terminal.Restore(fd, state)
+		}
+	}
+	fmt.Printf("Connected to serial console of %s, press ^] to quit\r\n", hosts[0])
+
+	done := make(chan error, 2)
+	go func() {
+		_, err := io.Copy(os.Stdout, sol)
+		done <- err
+	}()
+	go func() {
+		buffer := make([]byte, 1024)
+		for {
+			n, err := os.Stdin.Read(buffer)
+			if err != nil {
+				done <- err
+				return
+			}
+			if i := bytes.IndexByte(buffer[:n], solEscape); i >= 0 {
+				sol.Write(buffer[:i])
+				done <- nil
+				return
+			}
+			if _, err = sol.Write(buffer[:n]); err != nil {
+				done <- err
+				return
+			}
+		}
+	}()
+	if err := <-done; err != nil && err != io.EOF {
+		fmt.Printf("\r\nError: %s\r\n", err)
+	}
+	fmt.Printf("\r\nConnection to %s closed\r\n", hosts[0])
+}
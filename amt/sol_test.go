@@ -0,0 +1,121 @@
+package amt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+)
+
+// fakeRedirection accepts a single redirection session on a local port,
+// checks digest authentication of admin/secret and runs serve afterwards.
+func fakeRedirection(t *testing.T, session string, serve func(conn net.Conn)) net.Listener {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("cannot listen: %s", err)
+	}
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		read := func(n int) []byte {
+			data := make([]byte, n)
+			if _, err := io.ReadFull(conn, data); err != nil {
+				t.Errorf("fake redirection read failed: %s", err)
+			}
+			return data
+		}
+		readAuthentication := func() (fields []string) {
+			header := read(9)
+			if header[0] != redirAuthenticate || header[4] != redirAuthDigest {
+				t.Errorf("Unexpected authentication message %v", header)
+			}
+			data := read(int(binary.LittleEndian.Uint32(header[5:9])))
+			for len(data) > 0 {
+				fields = append(fields, string(data[1:1+data[0]]))
+				data = data[1+data[0]:]
+			}
+			return
+		}
+
+		if start := read(8); start[0] != redirStartSession || string(start[4:]) != session {
+			t.Errorf("Unexpected session start %v", start)
+		}
+		conn.Write([]byte{redirStartSessionReply, 0, 1, 0, 1, 0, 0, 0, 0, 0, 0, 0, 2, 'O', 'K'})
+
+		if fields := readAuthentication(); len(fields) != 8 || fields[0] != "admin" || fields[3] != redirAuthURI {
+			t.Errorf("Unexpected initial authentication %q", fields)
+		}
+		challenge := []byte("\x0bDigest:1234\x05nonce\x04auth")
+		conn.Write(append([]byte{redirAuthenticateReply, 1, 0, 0, redirAuthDigest, byte(len(challenge)), 0, 0, 0}, challenge...))
+
+		fields := readAuthentication()
+		status := byte(1)
+		if len(fields) == 8 && fields[6] == redirDigestResponse("admin", "secret", "Digest:1234", "nonce", fields[5], fields[4], "auth") {
+			status = 0
+		}
+		conn.Write([]byte{redirAuthenticateReply, status, 0, 0, redirAuthDigest, 0, 0, 0, 0})
+		if status == 0 {
+			serve(conn)
+		}
+	}()
+	return listener
+}
+
+func TestSOL(t *testing.T) {
+	listener := fakeRedirection(t, RedirectionSOL, func(conn net.Conn) {
+		settings := make([]byte, 24)
+		io.ReadFull(conn, settings)
+		if settings[0] != solStart || binary.LittleEndian.Uint16(settings[8:10]) != solMaxTxBuffer {
+			t.Errorf("Unexpected SOL settings %v", settings)
+		}
+		conn.Write(append([]byte{solStartReply, 0}, make([]byte, 21)...))
+		conn.Write([]byte{solHeartbeat, 0, 0, 0, 0, 0, 0, 0})
+		conn.Write(append([]byte{solDataFromHost, 0, 0, 0, 1, 0, 0, 0, 7, 0}, "login: "...))
+
+		data := make([]byte, 15)
+		io.ReadFull(conn, data)
+		if data[0] != solDataToHost || string(data[10:]) != "root\r" {
+			t.Errorf("Unexpected SOL data %v", data)
+		}
+		conn.Write(append([]byte{solDataFromHost, 0, 0, 0, 2, 0, 0, 0, 5, 0}, "root\r"...))
+		conn.Write([]byte{solEnd, 0, 0, 0, 3, 0, 0, 0})
+	})
+	defer listener.Close()
+
+	sol := NewSOL("localhost", Optionset{OptTimeout: 5, Username: "admin", Password: "secret"})
+	sol.Address = listener.Addr().String()
+	if err := sol.Open(); err != nil {
+		t.Fatalf("Open failed: %s", err)
+	}
+	defer sol.Close()
+
+	prompt := make([]byte, 7)
+	if _, err := io.ReadFull(sol, prompt); err != nil || string(prompt) != "login: " {
+		t.Fatalf("Unexpected SOL output %q (%v)", prompt, err)
+	}
+	if _, err := sol.Write([]byte("root\r")); err != nil {
+		t.Fatalf("Write failed: %s", err)
+	}
+	var output bytes.Buffer
+	if _, err := io.Copy(&output, sol); err != nil || output.String() != "root\r" {
+		t.Errorf("Unexpected SOL echo %q (%v)", output.String(), err)
+	}
+}
+
+func TestSOLAuthenticationFailure(t *testing.T) {
+	listener := fakeRedirection(t, RedirectionSOL, func(conn net.Conn) {
+		t.Errorf("Wrong password accepted")
+	})
+	defer listener.Close()
+
+	sol := NewSOL("localhost", Optionset{OptTimeout: 5, Username: "admin", Password: "wrong"})
+	sol.Address = listener.Addr().String()
+	if err := sol.Open(); err == nil {
+		t.Errorf("Open succeeded with wrong password")
+		sol.Close()
+	}
+}
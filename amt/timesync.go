@@ -0,0 +1,83 @@
+package amt
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+)
+
+// Clock returns the current time of the AMT clock, at one second accuracy.
+func (c *Client) Clock() (time.Time, error) {
+	var output struct {
+		Ta0         int64
+		ReturnValue int
+	}
+	if err := c.Invoke(amtTimeSynchronizationService, "GetLowAccuracyTimeSynch", nil, &output); err != nil {
+		return time.Time{}, err
+	}
+	if err := checkReturnValue("GetLowAccuracyTimeSynch", output.ReturnValue); err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(output.Ta0, 0), nil
+}
+
+// ClockDrift returns the offset of the AMT clock from local time.
+func (c *Client) ClockDrift() (time.Duration, error) {
+	clock, err := c.Clock()
+	if err != nil {
+		return 0, err
+	}
+	return clock.Sub(time.Now()).Round(time.Second), nil
+}
+
+// SyncClock sets the AMT clock to local time. It returns the drift
+// of the AMT clock found before synchronization.
+func (c *Client) SyncClock() (time.Duration, error) {
+	clock, err := c.Clock()
+	if err != nil {
+		return 0, err
+	}
+	tm1 := time.Now()
+	var output struct{ ReturnValue int }
+	err = c.Invoke(amtTimeSynchronizationService, "SetHighAccuracyTimeSynch", nil, &output,
+		Property{Name: "Ta0", Value: strconv.FormatInt(clock.Unix(), 10)},
+		Property{Name: "Tm1", Value: strconv.FormatInt(tm1.Unix(), 10)},
+		Property{Name: "Tm2", Value: strconv.FormatInt(time.Now().Unix(), 10)})
+	if err != nil {
+		return 0, err
+	}
+	return clock.Sub(tm1).Round(time.Second), checkReturnValue("SetHighAccuracyTimeSynch", output.ReturnValue)
+}
+
+// CliTimesync reports the clock drift of a list of hosts and synchronizes
+// their clocks to local time, unless checkOnly is set.
+func CliTimesync(hosts []string, checkOnly bool, options Optionset) {
+	if len(hosts) == 0 {
+		fmt.Println("Error: Expected list of hostnames as arguments")
+		return
+	}
+	options = cliOptions(options)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Host\tDrift\tStatus")
+	for _, host := range hosts {
+		client := NewClient(host, options)
+		var drift time.Duration
+		var err error
+		status := "synchronized"
+		if checkOnly {
+			drift, err = client.ClockDrift()
+			status = "checked"
+		} else {
+			drift, err = client.SyncClock()
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: Error: %s\n", host, err)
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%+ds\t%s\n", host, int64(drift/time.Second), status)
+	}
+	w.Flush()
+}
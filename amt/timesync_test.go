@@ -0,0 +1,36 @@
+package amt
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSyncClock(t *testing.T) {
+	amtTime := time.Now().Add(-90 * time.Second).Unix()
+	server, client := fakeAMT(t, func(action string, envelope string) (int, string) {
+		switch action {
+		case amtTimeSynchronizationService + "/GetLowAccuracyTimeSynch":
+			return 200, testResponse(action+"Response", `<h:GetLowAccuracyTimeSynch_OUTPUT>`+
+				`<h:Ta0>`+strconv.FormatInt(amtTime, 10)+`</h:Ta0><h:ReturnValue>0</h:ReturnValue></h:GetLowAccuracyTimeSynch_OUTPUT>`)
+		case amtTimeSynchronizationService + "/SetHighAccuracyTimeSynch":
+			if !strings.Contains(envelope, "<h:Ta0>"+strconv.FormatInt(amtTime, 10)+"</h:Ta0>") {
+				t.Errorf("SetHighAccuracyTimeSynch lacks Ta0:\n%s", envelope)
+			}
+			return 200, testResponse(action+"Response", `<h:SetHighAccuracyTimeSynch_OUTPUT>`+
+				`<h:ReturnValue>0</h:ReturnValue></h:SetHighAccuracyTimeSynch_OUTPUT>`)
+		}
+		t.Errorf("Unexpected action %s", action)
+		return 400, ""
+	})
+	defer server.Close()
+
+	drift, err := client.SyncClock()
+	if err != nil {
+		t.Fatalf("SyncClock failed: %s", err)
+	}
+	if drift > -89*time.Second || drift < -91*time.Second {
+		t.Errorf("Expected drift of -90s, got %s", drift)
+	}
+}
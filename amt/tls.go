@@ -0,0 +1,169 @@
+package amt
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+)
+
+// AMT_PublicKeyManagementService ReturnValue of already present certificates
+const returnValueDuplicate = 2058
+
+// InstanceID of the remote (network) interface's TLS settings
+const remoteTLSSettingsID = "Intel(r) AMT 802.3 TLS Settings"
+
+// selector of the collection TLS credentials are provided to
+var tlsProtocolEndpointCollection = &EndpointReference{
+	ResourceURI: amtTLSProtocolEndpointCollection,
+	Selectors:   []Selector{{"ElementName", "TLSProtocolEndpointInstances Collection"}},
+}
+
+var oidSHA256WithRSA = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 11}
+
+// certificationRequest is a PKCS#10 request, as of RFC 2986.
+type certificationRequest struct {
+	Info               certificationRequestInfo
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          asn1.BitString
+}
+
+type certificationRequestInfo struct {
+	Version    int
+	Subject    asn1.RawValue
+	PublicKey  asn1.RawValue
+	Attributes []asn1.RawValue `asn1:"tag:0"`
+}
+
+// nullSignedRequest returns a DER encoded PKCS#10 request for publicKey,
+// having a zero signature -- AMT signs it using the matching private key.
+func nullSignedRequest(commonName string, publicKey *rsa.PublicKey) ([]byte, error) {
+	subject, err := asn1.Marshal(pkix.Name{CommonName: commonName}.ToRDNSequence())
+	if err != nil {
+		return nil, err
+	}
+	spki, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(certificationRequest{
+		Info: certificationRequestInfo{
+			Subject:    asn1.RawValue{FullBytes: subject},
+			PublicKey:  asn1.RawValue{FullBytes: spki},
+			Attributes: []asn1.RawValue{},
+		},
+		SignatureAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidSHA256WithRSA, Parameters: asn1.NullRawValue},
+		Signature:          asn1.BitString{Bytes: make([]byte, publicKey.Size()), BitLength: 8 * publicKey.Size()},
+	})
+}
+
+// CertificateRequest generates a 2048 bit RSA key pair on AMT and returns
+// a certificate request for it, signed by AMT.
+func (c *Client) CertificateRequest(commonName string) (*x509.CertificateRequest, error) {
+	var keyPair struct {
+		KeyPair     endpointReferenceOutput
+		ReturnValue int
+	}
+	err := c.Invoke(amtPublicKeyManagementService, "GenerateKeyPair", nil, &keyPair,
+		Property{Name: "KeyAlgorithm", Value: "0"}, // RSA
+		Property{Name: "KeyLength", Value: "2048"})
+	if err != nil {
+		return nil, err
+	}
+	if err = checkReturnValue("GenerateKeyPair", keyPair.ReturnValue); err != nil {
+		return nil, err
+	}
+
+	var key struct{ DERKey string }
+	if err = c.Get(amtPublicPrivateKeyPair, keyPair.KeyPair.Selectors, &key); err != nil {
+		return nil, err
+	}
+	der, err := base64.StdEncoding.DecodeString(key.DERKey)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := x509.ParsePKCS1PublicKey(der)
+	if err != nil {
+		return nil, err
+	}
+	request, err := nullSignedRequest(commonName, publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var output struct {
+		SignedCertificateRequest string
+		ReturnValue              int
+	}
+	err = c.Invoke(amtPublicKeyManagementService, "GeneratePKCS10RequestEx", nil, &output,
+		Property{Name: "KeyPair", Ref: keyPair.KeyPair.reference()},
+		Property{Name: "SigningAlgorithm", Value: "1"}, // SHA256
+		Property{Name: "NullSignedCertificateRequest", Value: base64.StdEncoding.EncodeToString(request)})
+	if err != nil {
+		return nil, err
+	}
+	if err = checkReturnValue("GeneratePKCS10RequestEx", output.ReturnValue); err != nil {
+		return nil, err
+	}
+	if der, err = base64.StdEncoding.DecodeString(output.SignedCertificateRequest); err != nil {
+		return nil, err
+	}
+	csr, err := x509.ParseCertificateRequest(der)
+	if err != nil {
+		return nil, err
+	}
+	return csr, csr.CheckSignature()
+}
+
+// EnableTLS installs a certificate (DER) issued for a key pair generated by
+// CertificateRequest and the issuing CA's certificate (DER), and enables TLS
+// on the network interface. Unencrypted WS-MAN (port 16992) is no longer
+// available once the changes are committed.
+func (c *Client) EnableTLS(certificate []byte, caCertificate []byte) error {
+	var output struct{ ReturnValue int }
+	err := c.Invoke(amtPublicKeyManagementService, "AddTrustedRootCertificate", nil, &output,
+		Property{Name: "CertificateBlob", Value: base64.StdEncoding.EncodeToString(caCertificate)})
+	if err != nil {
+		return err
+	}
+	if output.ReturnValue != returnValueDuplicate {
+		if err = checkReturnValue("AddTrustedRootCertificate", output.ReturnValue); err != nil {
+			return err
+		}
+	}
+
+	var added struct {
+		CreatedCertificate endpointReferenceOutput
+		ReturnValue        int
+	}
+	err = c.Invoke(amtPublicKeyManagementService, "AddCertificate", nil, &added,
+		Property{Name: "CertificateBlob", Value: base64.StdEncoding.EncodeToString(certificate)})
+	if err != nil {
+		return err
+	}
+	if err = checkReturnValue("AddCertificate", added.ReturnValue); err != nil {
+		return err
+	}
+
+	err = c.Create(amtTLSCredentialContext,
+		Property{Name: "ElementInContext", Ref: added.CreatedCertificate.reference()},
+		Property{Name: "ElementProvidingContext", Ref: tlsProtocolEndpointCollection})
+	if err != nil {
+		return fmt.Errorf("cannot use certificate for TLS (existing TLS credentials must be removed first): %s", err)
+	}
+
+	err = c.modify(amtTLSSettingData, []Selector{{"InstanceID", remoteTLSSettingsID}},
+		Property{Name: "Enabled", Value: strconv.FormatBool(true)},
+		Property{Name: "MutualAuthentication", Value: strconv.FormatBool(false)})
+	if err != nil {
+		return err
+	}
+	var commit struct{ ReturnValue int }
+	if err = c.Invoke(amtSetupAndConfigurationService, "CommitChanges", nil, &commit); err != nil {
+		return err
+	}
+	return checkReturnValue("CommitChanges", commit.ReturnValue)
+}
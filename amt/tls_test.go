@@ -0,0 +1,66 @@
+package amt
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"regexp"
+	"testing"
+)
+
+func TestCertificateRequest(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPairReference := `<h:KeyPair><a:Address>` + addressAnonymous + `</a:Address><a:ReferenceParameters>` +
+		`<w:ResourceURI>` + amtPublicPrivateKeyPair + `</w:ResourceURI><w:SelectorSet>` +
+		`<w:Selector Name="InstanceID">Intel(r) AMT Key: Handle: 3</w:Selector></w:SelectorSet></a:ReferenceParameters></h:KeyPair>`
+	nullSigned := regexp.MustCompile(`<h:NullSignedCertificateRequest>([^<]+)<`)
+
+	server, client := fakeAMT(t, func(action string, envelope string) (int, string) {
+		switch action {
+		case amtPublicKeyManagementService + "/GenerateKeyPair":
+			return 200, testResponse(action+"Response", `<h:GenerateKeyPair_OUTPUT>`+keyPairReference+
+				`<h:ReturnValue>0</h:ReturnValue></h:GenerateKeyPair_OUTPUT>`)
+		case actionGet:
+			if !regexp.MustCompile(`Selector Name="InstanceID">Intel\(r\) AMT Key: Handle: 3<`).MatchString(envelope) {
+				t.Errorf("Get lacks key pair selector:\n%s", envelope)
+			}
+			der := base64.StdEncoding.EncodeToString(x509.MarshalPKCS1PublicKey(&key.PublicKey))
+			return 200, testResponse(action+"Response", `<g:AMT_PublicPrivateKeyPair xmlns:g="`+amtPublicPrivateKeyPair+`">`+
+				`<g:DERKey>`+der+`</g:DERKey><g:InstanceID>Intel(r) AMT Key: Handle: 3</g:InstanceID></g:AMT_PublicPrivateKeyPair>`)
+		case amtPublicKeyManagementService + "/GeneratePKCS10RequestEx":
+			// sign the null-signed request as AMT does
+			match := nullSigned.FindStringSubmatch(envelope)
+			if match == nil {
+				t.Fatalf("GeneratePKCS10RequestEx lacks request:\n%s", envelope)
+			}
+			der, _ := base64.StdEncoding.DecodeString(match[1])
+			request, err := x509.ParseCertificateRequest(der)
+			if err != nil {
+				t.Fatalf("Invalid null-signed request: %s", err)
+			}
+			signed, err := x509.CreateCertificateRequest(rand.Reader,
+				&x509.CertificateRequest{Subject: request.Subject, SignatureAlgorithm: x509.SHA256WithRSA}, key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return 200, testResponse(action+"Response", `<h:GeneratePKCS10RequestEx_OUTPUT><h:SignedCertificateRequest>`+
+				base64.StdEncoding.EncodeToString(signed)+`</h:SignedCertificateRequest>`+
+				`<h:ReturnValue>0</h:ReturnValue></h:GeneratePKCS10RequestEx_OUTPUT>`)
+		}
+		t.Errorf("Unexpected action %s", action)
+		return 400, ""
+	})
+	defer server.Close()
+
+	csr, err := client.CertificateRequest("host1.example.com")
+	if err != nil {
+		t.Fatalf("CertificateRequest failed: %s", err)
+	}
+	if csr.Subject.CommonName != "host1.example.com" || csr.PublicKey.(*rsa.PublicKey).N.Cmp(key.N) != 0 {
+		t.Errorf("Unexpected certificate request: %+v", csr)
+	}
+}
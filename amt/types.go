@@ -7,9 +7,24 @@ type Laststate struct {
 	Hostname    string `json:"hostname"`
 	StateBegin  int    `json:"state_begin"`
 	OpenPort    int    `json:"open_port"`
-	StateAMT    int    `json:"state_amt"`
+	StateAMT    int    `json:"state_amt"` // legacy amtc code, see legacyPowerstateMap
 	StateHTTP   int    `json:"state_http"`
 	Usermessage string `json:"usermessage"` // amtgo only
+
+	// amtgo only: CIM PowerState as reported by AMT, see PowerStateText
+	StatePower     int    `json:"state_power"`
+	StatePowerText string `json:"state_power_text"`
+
+	// error of the last command, see ErrUnauthorized and friends
+	Err error `json:"-" db:"-"`
+}
+
+// PowerStateText returns the name of a CIM PowerState.
+func PowerStateText(state int) string {
+	if text, ok := powerstateTextMap[state]; ok {
+		return text
+	}
+	return powerstateTextMap[stateUnknown]
 }
 
 // Laststates is array of Laststate -- for ember
@@ -31,12 +46,23 @@ type Optionset struct {
 	OptTimeout     int    `json:"opt_timeout" db:"opt_timeout"`
 	OptPassfile    string `json:"opt_passfile" db:"opt_passfile"`
 	OptCacertfile  string `json:"opt_cacertfile" db:"opt_cacertfile"`
-	Username       string `json:"username"` // amtgo only
-	Password       string `json:"-"`        // amtgo only
+	Username       string `json:"username" db:"opt_username"` // amtgo only
+	Password       string `json:"-"`                          // amtgo only
 	CliDelay       int    `json:"-" db:"-"`
 	CliUseTLS      bool   `json:"-" db:"-"` // amtgo cli (bool) vs db (int) hack
 	CliSkipcertchk bool   `json:"-" db:"-"` // amtgo cli (bool) vs db (int) hack
 	CaCertData     []byte `json:"-" db:"-"` // loaded contents of OptCacertfile
+
+	// amtgo only: used by CmdBoot, CmdKvmPassword, CmdNetworkSet, CmdUser*, Cmd*subscribe and CmdAlarm*
+	Boot        BootOptions   `json:"-" db:"-"`
+	RFBPassword string        `json:"-" db:"-"`
+	Network     NetworkConfig `json:"-" db:"-"`
+	User        UserAccount   `json:"-" db:"-"`
+	AlertURL    string        `json:"-" db:"-"` // base URL of alert receiver, see AlertAddress
+	Alarm       Alarm         `json:"-" db:"-"`
+
+	// amtgo only: fall back from graceful to hard power commands unsupported by a host
+	SwFallback int `json:"sw_fallback" db:"sw_fallback"`
 }
 
 // Optionsets is ember array of Optionset
@@ -44,32 +70,51 @@ type Optionsets struct {
 	Optionsets []Optionset `json:"optionsets"`
 }
 
+// cmdinfo lists the WS-MAN requests issued, in order, for a command
 type cmdinfo struct {
-	IsTwoStep  bool
-	CommandOne string
-	CommandTwo string
+	Steps []Request
 }
 
 const (
 	CmdBootcfgPxe  = "BOOTCFGPXE" // https://github.com/golang/lint/issues/274
 	CmdBootcfgHdd  = "BOOTCFGHDD"
+	CmdBoot        = "BOOT"
 	CmdInfo        = "INFO"
 	CmdUp          = "UP"
 	CmdDown        = "DOWN"
 	CmdReset       = "RESET"
 	CmdReboot      = "REBOOT"
 	CmdShutdown    = "SHUTDOWN"
+	CmdCycle       = "CYCLE"
+	CmdSleep       = "SLEEP"
+	CmdHibernate   = "HIBERNATE"
+	CmdNMI         = "NMI"
+	CmdDiagnostic  = "DIAGNOSTIC"
 	CmdPingEnable  = "PINGENABLE"
 	CmdPingDisable = "PINGDISABLE"
 	CmdWebEnable   = "WEBENABLE"
 	CmdWebDisable  = "WEBDISABLE"
 	CmdSolEnable   = "SOLENABLE"
 	CmdSolDisable  = "SOLDISABLE"
+	CmdKvmEnable   = "KVMENABLE"
+	CmdKvmDisable  = "KVMDISABLE"
+	CmdKvmPassword = "KVMPASSWORD"
+	CmdConsentNone = "CONSENTNONE"
+	CmdConsentKvm  = "CONSENTKVM"
+	CmdConsentAll  = "CONSENTALL"
+	CmdNetworkSet  = "NETWORKSET"
+	CmdTimesync    = "TIMESYNC"
+	CmdUserAdd     = "USERADD"
+	CmdUserRemove  = "USERREMOVE"
+	CmdUserRealms  = "USERREALMS"
+	CmdSubscribe   = "SUBSCRIBE"
+	CmdUnsubscribe = "UNSUBSCRIBE"
+	CmdAlarmAdd    = "ALARMADD"
+	CmdAlarmDelete = "ALARMDELETE"
 )
 
 // ShortCommandMap as used by jobs / scheduled jobs via GUI / in DB
 var ShortCommandMap = map[string]string{
-	// FIXME: C/power_cycle is EOI only or just lost in wsman?????
 	"X": CmdBootcfgPxe,
 	"H": CmdBootcfgHdd,
 	"U": CmdUp,
@@ -77,8 +122,15 @@ var ShortCommandMap = map[string]string{
 	"R": CmdReset,
 	"B": CmdReboot,
 	"S": CmdShutdown,
+	"C": CmdCycle,
+	"L": CmdSleep,
+	"I": CmdHibernate,
+	"N": CmdNMI,
+	"G": CmdDiagnostic,
+	"T": CmdTimesync,
 }
 
+// PowerState values of CIM_AssociatedPowerManagementService, as reported by AMT
 const (
 	stateUnknown                   = 0
 	stateOther                     = 1
@@ -92,46 +144,88 @@ const (
 	stateCycleOffHard              = 9
 	stateMasterBusReset            = 10
 	stateNMI                       = 11
-	stateNotApplicable             = 12
-	stateOffSoftGraceful           = 13
-	stateOffHardGraceful           = 14
-	stateMasterBusResetGraceful    = 15
-	statePowerCycleOffSoftGraceful = 16
-	statePowerCycleOffHardGraceful = 17
-	stateDiagnosticInterrupt       = 18
+	stateOffSoftGraceful           = 12
+	stateOffHardGraceful           = 13
+	stateMasterBusResetGraceful    = 14
+	statePowerCycleOffSoftGraceful = 15
+	statePowerCycleOffHardGraceful = 16
+	stateDiagnosticInterrupt       = 17
 )
 
 var cmdMap = map[string]cmdinfo{
-	CmdBootcfgPxe:  {true, "wsman_pxeboot", "wsman_bootconfig"},
-	CmdBootcfgHdd:  {true, "wsman_hddboot", "wsman_bootconfig"},
-	CmdInfo:        {true, "wsman_info", "wsman_info_step2"},
-	CmdUp:          {false, "wsman_up", ""},
-	CmdDown:        {false, "wsman_down", ""},
-	CmdReset:       {false, "wsman_reset", ""},
-	CmdReboot:      {false, "wsman_reset_graceful", ""},
-	CmdShutdown:    {false, "wsman_shutdown_graceful", ""},
-	CmdPingEnable:  {false, "wsman_ping_enable", ""},
-	CmdPingDisable: {false, "wsman_ping_disable", ""},
-	CmdWebEnable:   {false, "wsman_webui_enable", ""},
-	CmdWebDisable:  {false, "wsman_webui_disable", ""},
-	CmdSolEnable:   {false, "wsman_solredir_enable", ""},
-	CmdSolDisable:  {false, "wsman_solredir_disable", ""},
+	CmdBootcfgPxe:  {[]Request{changeBootOrder(bootSourcePxe), setBootConfigRole()}},
+	CmdBootcfgHdd:  {[]Request{changeBootOrder(bootSourceHdd), setBootConfigRole()}},
+	CmdBoot:        {}, // see runMap
+	CmdInfo:        {}, // see Client.PowerState()
+	CmdUp:          {[]Request{requestPowerStateChange(requestPowerOn)}},
+	CmdDown:        {[]Request{requestPowerStateChange(requestPowerOffSoft)}},
+	CmdReset:       {[]Request{requestPowerStateChange(requestPowerMasterBusReset)}},
+	CmdReboot:      {[]Request{requestPowerStateChange(requestPowerMasterBusResetGraceful)}},
+	CmdShutdown:    {[]Request{requestPowerStateChange(requestPowerOffSoftGraceful)}},
+	CmdCycle:       {[]Request{requestPowerStateChange(requestPowerCycleOffSoft)}},
+	CmdSleep:       {[]Request{requestPowerStateChange(requestPowerSleepDeep)}},
+	CmdHibernate:   {[]Request{requestPowerStateChange(requestPowerHibernate)}},
+	CmdNMI:         {[]Request{requestPowerStateChange(requestPowerNMI)}},
+	CmdDiagnostic:  {[]Request{requestPowerStateChange(requestPowerDiagnosticInterrupt)}},
+	CmdPingEnable:  {[]Request{putPingResponse(true)}},
+	CmdPingDisable: {[]Request{putPingResponse(false)}},
+	CmdWebEnable:   {[]Request{webUIStateChange(2)}},
+	CmdWebDisable:  {[]Request{webUIStateChange(3)}},
+	CmdSolEnable:   {[]Request{putRedirectionListener(true)}},
+	CmdSolDisable:  {[]Request{putRedirectionListener(false)}},
+	CmdKvmEnable:   {[]Request{kvmStateChange(2)}},
+	CmdKvmDisable:  {[]Request{kvmStateChange(3)}},
+	CmdKvmPassword: {}, // see runMap
+	CmdConsentNone: {}, // see runMap
+	CmdConsentKvm:  {}, // see runMap
+	CmdConsentAll:  {}, // see runMap
+	CmdNetworkSet:  {}, // see runMap
+	CmdTimesync:    {}, // see runMap
+	CmdUserAdd:     {}, // see runMap
+	CmdUserRemove:  {}, // see runMap
+	CmdUserRealms:  {}, // see runMap
+	CmdSubscribe:   {}, // see runMap
+	CmdUnsubscribe: {}, // see runMap
+	CmdAlarmAdd:    {}, // see runMap
+	CmdAlarmDelete: {}, // see runMap
+}
+
+// runMap holds commands requiring more than fixed requests
+var runMap = map[string]func(c *Client, options Optionset) error{
+	CmdBoot:        func(c *Client, options Optionset) error { return c.Boot(options.Boot) },
+	CmdKvmPassword: func(c *Client, options Optionset) error { return c.SetRFBPassword(options.RFBPassword) },
+	CmdConsentNone: func(c *Client, options Optionset) error { return c.SetOptInRequired(optInNone) },
+	CmdConsentKvm:  func(c *Client, options Optionset) error { return c.SetOptInRequired(optInKVM) },
+	CmdConsentAll:  func(c *Client, options Optionset) error { return c.SetOptInRequired(optInAll) },
+	CmdNetworkSet:  func(c *Client, options Optionset) error { return c.SetNetwork(options.Network) },
+	CmdTimesync:    func(c *Client, options Optionset) error { _, err := c.SyncClock(); return err },
+	CmdUserAdd:     func(c *Client, options Optionset) error { return c.AddUser(options.User) },
+	CmdUserRemove:  func(c *Client, options Optionset) error { return c.RemoveUser(options.User.Username) },
+	CmdUserRealms:  func(c *Client, options Optionset) error { return c.SetUserRealms(options.User) },
+	CmdSubscribe: func(c *Client, options Optionset) error {
+		return c.Subscribe(AlertAddress(options.AlertURL, c.Hostname))
+	},
+	CmdUnsubscribe: func(c *Client, options Optionset) error {
+		return c.Unsubscribe(AlertAddress(options.AlertURL, c.Hostname))
+	},
+	CmdAlarmAdd:    func(c *Client, options Optionset) error { return c.AddAlarm(options.Alarm) },
+	CmdAlarmDelete: func(c *Client, options Optionset) error { return c.DeleteAlarm(options.Alarm.Name) },
 }
 
+// powerstateTextMap names reported and requested (request* constants) power states
 var powerstateTextMap = map[int]string{
 	stateUnknown:                   "Unknown",
 	stateOther:                     "Other",
 	stateOn:                        "On",
 	stateSleepLight:                "Sleep-Light",
 	stateSleepDeep:                 "Sleep-Deep",
-	stateCycleOffSoft:              "Cycle-Off-Soft",
+	stateCycleOffSoft:              "Power-Cycle-Off-Soft",
 	stateOffHard:                   "Off-Hard",
 	stateHibernate:                 "Hibernate",
 	stateOffSoft:                   "Off-Soft",
-	stateCycleOffHard:              "Cycle-Off-Hard",
+	stateCycleOffHard:              "Power-Cycle-Off-Hard",
 	stateMasterBusReset:            "Master-Bus-Reset",
 	stateNMI:                       "NMI",
-	stateNotApplicable:             "Not-Applicable",
 	stateOffSoftGraceful:           "Off-Soft-Graceful",
 	stateOffHardGraceful:           "Off-Hard-Graceful",
 	stateMasterBusResetGraceful:    "Master-Bus-Reset-Graceful",
@@ -160,7 +254,6 @@ var legacyPowerstateMap = map[int]int{
 	15: 9,
 	16: 9,
 	17: 9,
-	18: 9,
 }
 
 var legacyPowerstateTextMap = map[int]string{
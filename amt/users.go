@@ -0,0 +1,288 @@
+package amt
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// number of handles requested per EnumerateUserAclEntries call
+const userAclPageSize = 50
+
+// AccessPermission values of AMT user ACL entries
+var userAccessMap = map[string]int{
+	"local":   0,
+	"network": 1,
+	"any":     2,
+}
+
+// realm values of AMT user ACL entries
+var userRealmMap = map[string]int{
+	"redirection":                   2,
+	"pt-administration":             3,
+	"hardware-asset":                4,
+	"remote-control":                5,
+	"storage":                       6,
+	"event-manager":                 7,
+	"storage-administration":        8,
+	"agent-presence-local":          9,
+	"agent-presence-remote":         10,
+	"circuit-breaker":               11,
+	"network-time":                  12,
+	"general-info":                  13,
+	"firmware-update":               14,
+	"eit":                           15,
+	"local-un":                      16,
+	"endpoint-access-control":       17,
+	"endpoint-access-control-admin": 18,
+	"event-log-reader":              19,
+	"audit-log":                     20,
+	"acl":                           21,
+	"local-system":                  24,
+}
+
+// UserAccount is a digest user ACL entry of AMT_AuthorizationService.
+type UserAccount struct {
+	Handle   int      `json:"handle"`
+	Username string   `json:"username"`
+	Password string   `json:"-"`
+	Access   string   `json:"access"` // local, network or any
+	Realms   []string `json:"realms"`
+}
+
+// Validate checks username, access permission and realms of an account.
+// The password is checked only if non-empty.
+func (u UserAccount) Validate() error {
+	if u.Username == "" || len(u.Username) > 16 || strings.ContainsAny(u.Username, `:,<>&"`) {
+		return fmt.Errorf("invalid AMT username '%s'", u.Username)
+	}
+	if _, ok := userAccessMap[u.Access]; !ok {
+		return fmt.Errorf("unsupported access permission '%s', expected local, network or any", u.Access)
+	}
+	if len(u.Realms) == 0 {
+		return fmt.Errorf("no realms given")
+	}
+	for _, realm := range u.Realms {
+		if _, ok := userRealmMap[realm]; !ok {
+			return fmt.Errorf("unsupported realm '%s'", realm)
+		}
+	}
+	if u.Password != "" {
+		return CheckAdminPassword(u.Password)
+	}
+	return nil
+}
+
+// aclProperties returns AccessPermission and Realms input properties of u.
+func (u UserAccount) aclProperties() []Property {
+	properties := []Property{{Name: "AccessPermission", Value: strconv.Itoa(userAccessMap[u.Access])}}
+	for _, realm := range u.Realms {
+		properties = append(properties, Property{Name: "Realms", Value: strconv.Itoa(userRealmMap[realm])})
+	}
+	return properties
+}
+
+// Users returns all digest user accounts, excluding the admin user.
+func (c *Client) Users() ([]UserAccount, error) {
+	var handles []int
+	for {
+		var output struct {
+			TotalCount   int
+			HandlesCount int
+			Handles      []int
+			ReturnValue  int
+		}
+		err := c.Invoke(amtAuthorizationService, "EnumerateUserAclEntries", nil, &output,
+			Property{Name: "StartIndex", Value: strconv.Itoa(len(handles) + 1)})
+		if err != nil {
+			return nil, err
+		}
+		if err = checkReturnValue("EnumerateUserAclEntries", output.ReturnValue); err != nil {
+			return nil, err
+		}
+		handles = append(handles, output.Handles...)
+		if len(output.Handles) == 0 || len(output.Handles) < userAclPageSize || len(handles) >= output.TotalCount {
+			break
+		}
+	}
+
+	var users []UserAccount
+	for _, handle := range handles {
+		var entry struct {
+			DigestUsername   string
+			AccessPermission int
+			Realms           []int
+			ReturnValue      int
+		}
+		err := c.Invoke(amtAuthorizationService, "GetUserAclEntryEx", nil, &entry,
+			Property{Name: "Handle", Value: strconv.Itoa(handle)})
+		if err != nil {
+			return nil, err
+		}
+		if err = checkReturnValue("GetUserAclEntryEx", entry.ReturnValue); err != nil {
+			return nil, err
+		}
+		if entry.DigestUsername == "" {
+			continue // kerberos user
+		}
+		user := UserAccount{Handle: handle, Username: entry.DigestUsername}
+		for name, value := range userAccessMap {
+			if value == entry.AccessPermission {
+				user.Access = name
+			}
+		}
+		for _, realm := range entry.Realms {
+			user.Realms = append(user.Realms, realmName(realm))
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+// realmName returns the name of an AMT realm value.
+func realmName(realm int) string {
+	for name, value := range userRealmMap {
+		if value == realm {
+			return name
+		}
+	}
+	return strconv.Itoa(realm)
+}
+
+// findUser returns the account named username.
+func (c *Client) findUser(username string) (UserAccount, error) {
+	users, err := c.Users()
+	if err != nil {
+		return UserAccount{}, err
+	}
+	for _, user := range users {
+		if user.Username == username {
+			return user, nil
+		}
+	}
+	return UserAccount{}, fmt.Errorf("no AMT user named '%s'", username)
+}
+
+// AddUser adds a digest user account.
+func (c *Client) AddUser(user UserAccount) error {
+	if err := user.Validate(); err != nil {
+		return err
+	}
+	if user.Password == "" {
+		return fmt.Errorf("no password given for new user '%s'", user.Username)
+	}
+	digest, err := c.digestPassword(user.Username, user.Password)
+	if err != nil {
+		return err
+	}
+	input := append([]Property{
+		{Name: "DigestUsername", Value: user.Username},
+		{Name: "DigestPassword", Value: digest},
+	}, user.aclProperties()...)
+	var output struct{ ReturnValue int }
+	if err = c.Invoke(amtAuthorizationService, "AddUserAclEntryEx", nil, &output, input...); err != nil {
+		return err
+	}
+	return checkReturnValue("AddUserAclEntryEx", output.ReturnValue)
+}
+
+// RemoveUser removes the digest user account named username.
+func (c *Client) RemoveUser(username string) error {
+	user, err := c.findUser(username)
+	if err != nil {
+		return err
+	}
+	var output struct{ ReturnValue int }
+	err = c.Invoke(amtAuthorizationService, "RemoveUserAclEntry", nil, &output,
+		Property{Name: "Handle", Value: strconv.Itoa(user.Handle)})
+	if err != nil {
+		return err
+	}
+	return checkReturnValue("RemoveUserAclEntry", output.ReturnValue)
+}
+
+// SetUserRealms replaces access permission and realms of an existing
+// account; its password is kept.
+func (c *Client) SetUserRealms(user UserAccount) error {
+	user.Password = ""
+	if err := user.Validate(); err != nil {
+		return err
+	}
+	existing, err := c.findUser(user.Username)
+	if err != nil {
+		return err
+	}
+	input := append([]Property{
+		{Name: "Handle", Value: strconv.Itoa(existing.Handle)},
+		{Name: "DigestUsername", Value: user.Username},
+	}, user.aclProperties()...)
+	var output struct{ ReturnValue int }
+	if err = c.Invoke(amtAuthorizationService, "UpdateUserAclEntryEx", nil, &output, input...); err != nil {
+		return err
+	}
+	return checkReturnValue("UpdateUserAclEntryEx", output.ReturnValue)
+}
+
+// CliUsers prints the digest user accounts of a list of hosts.
+func CliUsers(hosts []string, format string, options Optionset) {
+	if len(hosts) == 0 {
+		fmt.Println("Error: Expected list of hostnames as arguments")
+		return
+	}
+	if format != FormatJSON && format != FormatTable {
+		fmt.Printf("Error: Unsupported output format %s\n", format)
+		return
+	}
+	options = cliOptions(options)
+
+	results := make(map[string][]UserAccount)
+	for _, host := range hosts {
+		users, err := NewClient(host, options).Users()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: Error: %s\n", host, err)
+			continue
+		}
+		results[host] = users
+	}
+
+	if format == FormatJSON {
+		data, _ := json.MarshalIndent(results, "", "  ")
+		fmt.Println(string(data))
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Host\tHandle\tUsername\tAccess\tRealms")
+	for _, host := range hosts {
+		for _, user := range results[host] {
+			fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n", host, user.Handle, user.Username, user.Access, strings.Join(user.Realms, ","))
+		}
+	}
+	w.Flush()
+}
+
+// CliUserCommand runs CmdUserAdd, CmdUserRemove or CmdUserRealms for user on a list of hosts.
+func CliUserCommand(cmd string, user UserAccount, hosts []string, options Optionset) {
+	var err error
+	switch cmd {
+	case CmdUserAdd:
+		if err = user.Validate(); err == nil && user.Password == "" {
+			err = fmt.Errorf("no password given for new user '%s'", user.Username)
+		}
+	case CmdUserRealms:
+		user.Password = ""
+		err = user.Validate()
+	case CmdUserRemove:
+		if user.Username == "" {
+			err = fmt.Errorf("no username given")
+		}
+	}
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+	options.User = user
+	CliCommand(cmd, hosts, options)
+}
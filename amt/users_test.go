@@ -0,0 +1,63 @@
+package amt
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUsers(t *testing.T) {
+	var updated string
+	server, client := fakeAMT(t, func(action string, envelope string) (int, string) {
+		switch action {
+		case amtAuthorizationService + "/EnumerateUserAclEntries":
+			return 200, testResponse(action+"Response", `<h:EnumerateUserAclEntries_OUTPUT><h:TotalCount>2</h:TotalCount>`+
+				`<h:HandlesCount>2</h:HandlesCount><h:Handles>7</h:Handles><h:Handles>9</h:Handles>`+
+				`<h:ReturnValue>0</h:ReturnValue></h:EnumerateUserAclEntries_OUTPUT>`)
+		case amtAuthorizationService + "/GetUserAclEntryEx":
+			if strings.Contains(envelope, "<h:Handle>9</h:Handle>") {
+				return 200, testResponse(action+"Response", `<h:GetUserAclEntryEx_OUTPUT><h:KerberosUserSid>AQUAAAAAAAUVAAAA</h:KerberosUserSid>`+
+					`<h:AccessPermission>2</h:AccessPermission><h:Realms>3</h:Realms><h:ReturnValue>0</h:ReturnValue></h:GetUserAclEntryEx_OUTPUT>`)
+			}
+			return 200, testResponse(action+"Response", `<h:GetUserAclEntryEx_OUTPUT><h:DigestUsername>helpdesk</h:DigestUsername>`+
+				`<h:AccessPermission>1</h:AccessPermission><h:Realms>5</h:Realms><h:Realms>13</h:Realms>`+
+				`<h:ReturnValue>0</h:ReturnValue></h:GetUserAclEntryEx_OUTPUT>`)
+		case amtAuthorizationService + "/UpdateUserAclEntryEx":
+			updated = envelope
+			return 200, testResponse(action+"Response", `<h:UpdateUserAclEntryEx_OUTPUT>`+
+				`<h:ReturnValue>0</h:ReturnValue></h:UpdateUserAclEntryEx_OUTPUT>`)
+		}
+		t.Errorf("Unexpected action %s", action)
+		return 400, ""
+	})
+	defer server.Close()
+
+	users, err := client.Users()
+	if err != nil {
+		t.Fatalf("Users failed: %s", err)
+	}
+	if len(users) != 1 || users[0].Handle != 7 || users[0].Access != "network" ||
+		strings.Join(users[0].Realms, ",") != "remote-control,general-info" {
+		t.Errorf("Unexpected users: %+v", users)
+	}
+
+	user := UserAccount{Username: "helpdesk", Access: "any", Realms: []string{"remote-control", "redirection"}}
+	if err = client.SetUserRealms(user); err != nil {
+		t.Fatalf("SetUserRealms failed: %s", err)
+	}
+	for _, expected := range []string{"<h:Handle>7</h:Handle>", "<h:AccessPermission>2</h:AccessPermission>",
+		"<h:Realms>5</h:Realms><h:Realms>2</h:Realms>"} {
+		if !strings.Contains(updated, expected) {
+			t.Errorf("UpdateUserAclEntryEx lacks %s:\n%s", expected, updated)
+		}
+	}
+	if strings.Contains(updated, "DigestPassword") {
+		t.Errorf("UpdateUserAclEntryEx changes password:\n%s", updated)
+	}
+
+	if err = client.RemoveUser("nobody"); err == nil {
+		t.Error("RemoveUser accepted unknown user")
+	}
+	if err = (UserAccount{Username: "helpdesk", Access: "any", Realms: []string{"power"}}).Validate(); err == nil {
+		t.Error("Validate accepted unknown realm")
+	}
+}
@@ -0,0 +1,137 @@
+package amt
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// UDP port magic packets are sent to ("discard")
+const wakeOnLANPort = 9
+
+// limited broadcast, used if a host's subnet is unknown
+const broadcastAll = "255.255.255.255"
+
+// WakeTarget describes how to wake a host using Wake-on-LAN.
+type WakeTarget struct {
+	MACAddress string
+	Broadcast  string // address magic packets are sent to, e.g. a subnet-directed broadcast
+	Only       bool   // host lacks usable AMT: always wake by Wake-on-LAN
+}
+
+// WakeTargetStore provides Wake-on-LAN targets by hostname. If set (the
+// server uses the database), UP commands of hosts flagged "WoL only" send
+// magic packets, as do UP commands failing without HTTP response.
+var WakeTargetStore interface {
+	GetWakeTarget(hostname string) (WakeTarget, bool)
+}
+
+// WakeOnLAN sends a magic packet for macAddress to broadcast (UDP port 9).
+func WakeOnLAN(macAddress string, broadcast string) error {
+	packet, err := magicPacket(macAddress)
+	if err != nil {
+		return err
+	}
+	if broadcast == "" {
+		broadcast = broadcastAll
+	}
+	if net.ParseIP(broadcast) == nil {
+		return fmt.Errorf("invalid broadcast address '%s'", broadcast)
+	}
+	conn, err := net.Dial("udp", net.JoinHostPort(broadcast, fmt.Sprint(wakeOnLANPort)))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = conn.Write(packet)
+	return err
+}
+
+// magicPacket returns the Wake-on-LAN magic packet of macAddress:
+// 6 bytes 0xff, followed by 16 repetitions of the MAC address.
+func magicPacket(macAddress string) ([]byte, error) {
+	mac, err := net.ParseMAC(macAddress)
+	if err != nil || len(mac) != 6 {
+		return nil, fmt.Errorf("invalid MAC address '%s'", macAddress)
+	}
+	return append(bytes.Repeat([]byte{0xff}, 6), bytes.Repeat(mac, 16)...), nil
+}
+
+// DirectedBroadcast returns the broadcast address of the IPv4 subnet of
+// ipAddress, or "" if ipAddress or subnetMask are invalid.
+func DirectedBroadcast(ipAddress string, subnetMask string) string {
+	ip := net.ParseIP(ipAddress).To4()
+	mask := net.ParseIP(subnetMask).To4()
+	if ip == nil || mask == nil {
+		return ""
+	}
+	broadcast := make(net.IP, 4)
+	for i := range ip {
+		broadcast[i] = ip[i] | ^mask[i]
+	}
+	return broadcast.String()
+}
+
+// wakesUp returns true if cmd powers on a host, which Wake-on-LAN can replace.
+func wakesUp(cmd string, options Optionset) bool {
+	return cmd == CmdUp || (cmd == CmdBoot && options.Boot.Then == CmdUp)
+}
+
+// wakeTarget returns the Wake-on-LAN target of hostname from WakeTargetStore.
+func wakeTarget(hostname string) (WakeTarget, bool) {
+	if WakeTargetStore == nil {
+		return WakeTarget{}, false
+	}
+	return WakeTargetStore.GetWakeTarget(hostname)
+}
+
+// CliWakeTargets is a WakeTargetStore of MAC addresses given on the
+// command line, enabling the Wake-on-LAN fallback of CLI power-ups.
+type CliWakeTargets map[string]WakeTarget
+
+// GetWakeTarget returns the Wake-on-LAN target given for hostname.
+func (t CliWakeTargets) GetWakeTarget(hostname string) (WakeTarget, bool) {
+	target, ok := t[hostname]
+	return target, ok
+}
+
+// ParseWakeTargets parses hostname=MAC pairs, e.g. of --wol-mac flags.
+// Magic packets go to broadcast or, if empty, 255.255.255.255.
+func ParseWakeTargets(pairs []string, broadcast string) (CliWakeTargets, error) {
+	if broadcast == "" {
+		broadcast = broadcastAll
+	} else if net.ParseIP(broadcast).To4() == nil {
+		return nil, fmt.Errorf("invalid broadcast address '%s'", broadcast)
+	}
+	targets := make(CliWakeTargets)
+	for _, pair := range pairs {
+		hostname, mac := pair, ""
+		if i := strings.LastIndex(pair, "="); i > 0 {
+			hostname, mac = pair[:i], pair[i+1:]
+		}
+		if _, err := magicPacket(mac); err != nil {
+			return nil, fmt.Errorf("invalid Wake-on-LAN target %s, expected hostname=MAC", pair)
+		}
+		targets[hostname] = WakeTarget{MACAddress: mac, Broadcast: broadcast}
+	}
+	return targets, nil
+}
+
+// CliWakeOnLAN sends magic packets for a list of MAC addresses.
+func CliWakeOnLAN(macAddresses []string, broadcast string) {
+	if len(macAddresses) == 0 {
+		fmt.Println("Error: Expected list of MAC addresses as arguments")
+		return
+	}
+	if broadcast == "" {
+		broadcast = broadcastAll
+	}
+	for _, mac := range macAddresses {
+		if err := WakeOnLAN(mac, broadcast); err != nil {
+			fmt.Printf("WOL %-17s Error: %s\n", mac, err)
+			continue
+		}
+		fmt.Printf("WOL %-17s magic packet sent to %s\n", mac, broadcast)
+	}
+}
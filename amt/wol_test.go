@@ -0,0 +1,79 @@
+package amt
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+type testWakeTargetStore map[string]WakeTarget
+
+func (s testWakeTargetStore) GetWakeTarget(hostname string) (WakeTarget, bool) {
+	target, ok := s[hostname]
+	return target, ok
+}
+
+func TestMagicPacket(t *testing.T) {
+	packet, err := magicPacket("00-1a-2b-3c-4d-5e")
+	if err != nil || len(packet) != 102 {
+		t.Fatalf("Unexpected magic packet %x, %v", packet, err)
+	}
+	if !bytes.Equal(packet[:6], []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff}) ||
+		!bytes.Equal(packet[96:], []byte{0x00, 0x1a, 0x2b, 0x3c, 0x4d, 0x5e}) {
+		t.Errorf("Unexpected magic packet %x", packet)
+	}
+	if _, err = magicPacket("00:1a:2b"); err == nil {
+		t.Errorf("Expected error for invalid MAC address")
+	}
+}
+
+func TestDirectedBroadcast(t *testing.T) {
+	if b := DirectedBroadcast("10.1.19.42", "255.255.254.0"); b != "10.1.19.255" {
+		t.Errorf("Unexpected broadcast %s", b)
+	}
+	if b := DirectedBroadcast("10.1.19.42", ""); b != "" {
+		t.Errorf("Expected no broadcast without subnet mask, got %s", b)
+	}
+}
+
+func TestWakeOnLANFallback(t *testing.T) {
+	defer func() { WakeTargetStore = nil }()
+	WakeTargetStore = testWakeTargetStore{
+		"localhost":   {MACAddress: "00-1a-2b-3c-4d-5e", Broadcast: "127.0.0.1"},
+		"wol-only-pc": {MACAddress: "00-1a-2b-3c-4d-5f", Broadcast: "127.0.0.1", Only: true},
+	}
+
+	result := Command(Laststate{Hostname: "wol-only-pc"}, CmdUp, Optionset{OptTimeout: 1})
+	if result.Usermessage != "Wake-on-LAN sent (WoL only host)" {
+		t.Errorf("Unexpected WoL only result %+v", result)
+	}
+
+	// AMT unreachable (nothing listens on localhost:16992): fall back to Wake-on-LAN
+	result = Command(Laststate{Hostname: "localhost"}, CmdUp, Optionset{OptTimeout: 1})
+	if result.StateHTTP != 0 || !strings.HasSuffix(result.Usermessage, "; sent Wake-on-LAN instead") {
+		t.Errorf("Unexpected fallback result %+v", result)
+	}
+	result = Command(Laststate{Hostname: "localhost"}, CmdDown, Optionset{OptTimeout: 1})
+	if strings.Contains(result.Usermessage, "Wake-on-LAN") {
+		t.Errorf("Wake-on-LAN must only replace power-up: %+v", result)
+	}
+}
+
+func TestParseWakeTargets(t *testing.T) {
+	targets, err := ParseWakeTargets([]string{"host1=00-1a-2b-3c-4d-5e"}, "")
+	if err != nil {
+		t.Fatalf("ParseWakeTargets failed: %s", err)
+	}
+	target, ok := targets.GetWakeTarget("host1")
+	if !ok || target.MACAddress != "00-1a-2b-3c-4d-5e" || target.Broadcast != broadcastAll || target.Only {
+		t.Errorf("Unexpected target %+v", target)
+	}
+	if _, ok = targets.GetWakeTarget("host2"); ok {
+		t.Error("Unexpected target for host2")
+	}
+	for _, pairs := range [][]string{{"00-1a-2b-3c-4d-5e"}, {"host1=nomac"}} {
+		if _, err = ParseWakeTargets(pairs, ""); err == nil {
+			t.Errorf("Expected error parsing %v", pairs)
+		}
+	}
+}
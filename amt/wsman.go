@@ -0,0 +1,275 @@
+package amt
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// XML namespaces used by WS-MAN requests
+const (
+	nsSOAP           = "http://www.w3.org/2003/05/soap-envelope"
+	nsAddressing     = "http://schemas.xmlsoap.org/ws/2004/08/addressing"
+	nsWsman          = "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd"
+	nsEnumeration    = "http://schemas.xmlsoap.org/ws/2004/09/enumeration"
+	nsTransfer       = "http://schemas.xmlsoap.org/ws/2004/09/transfer"
+	nsEventing       = "http://schemas.xmlsoap.org/ws/2004/08/eventing"
+	addressAnonymous = nsAddressing + "/role/anonymous"
+)
+
+// WS-Transfer, WS-Enumeration and WS-Eventing actions
+const (
+	actionGet       = nsTransfer + "/Get"
+	actionPut       = nsTransfer + "/Put"
+	actionCreate    = nsTransfer + "/Create"
+	actionDelete    = nsTransfer + "/Delete"
+	actionEnumerate = nsEnumeration + "/Enumerate"
+	actionPull      = nsEnumeration + "/Pull"
+	actionSubscribe = nsEventing + "/Subscribe"
+)
+
+// Selector is a single key of a WS-MAN SelectorSet.
+type Selector struct {
+	Name  string `xml:"Name,attr"`
+	Value string `xml:",chardata"`
+}
+
+// EndpointReference points to a CIM instance, e.g. as method parameter.
+type EndpointReference struct {
+	ResourceURI string
+	Selectors   []Selector
+}
+
+// endpointReferenceOutput decodes an EndpointReference of method output.
+type endpointReferenceOutput struct {
+	ResourceURI string     `xml:"ReferenceParameters>ResourceURI"`
+	Selectors   []Selector `xml:"ReferenceParameters>SelectorSet>Selector"`
+}
+
+func (e endpointReferenceOutput) reference() *EndpointReference {
+	return &EndpointReference{ResourceURI: e.ResourceURI, Selectors: e.Selectors}
+}
+
+// Property is a named value within a request body.
+// If Ref is set, the property holds an EndpointReference instead of Value.
+type Property struct {
+	Name  string
+	Value string
+	Ref   *EndpointReference
+}
+
+// Request describes a single WS-MAN request.
+// Body is marshalled into the SOAP body using encoding/xml; it may be nil.
+type Request struct {
+	Action      string
+	ResourceURI string
+	Selectors   []Selector
+	Body        interface{}
+}
+
+// Response is a WS-MAN response as received from AMT.
+type Response struct {
+	StatusCode int
+	Action     string
+	MessageID  string
+	Body       []byte // inner XML of SOAP body
+}
+
+// envelope and friends are used for marshalling requests only.
+// Element names carry fixed prefixes, declared on the envelope.
+type envelope struct {
+	XMLName  xml.Name `xml:"s:Envelope"`
+	XmlnsS   string   `xml:"xmlns:s,attr"`
+	XmlnsWsa string   `xml:"xmlns:wsa,attr"`
+	XmlnsWsm string   `xml:"xmlns:wsman,attr"`
+	Header   header   `xml:"s:Header"`
+	Body     body     `xml:"s:Body"`
+}
+
+type header struct {
+	Action      mustUnderstand `xml:"wsa:Action"`
+	To          mustUnderstand `xml:"wsa:To"`
+	ResourceURI mustUnderstand `xml:"wsman:ResourceURI"`
+	MessageID   mustUnderstand `xml:"wsa:MessageID"`
+	ReplyTo     string         `xml:"wsa:ReplyTo>wsa:Address"`
+	SelectorSet *selectorSet   `xml:"wsman:SelectorSet,omitempty"`
+}
+
+type selectorSet struct {
+	Selectors []Selector `xml:"wsman:Selector"`
+}
+
+type mustUnderstand struct {
+	Value          string `xml:",chardata"`
+	MustUnderstand bool   `xml:"s:mustUnderstand,attr"`
+}
+
+type body struct {
+	Content interface{}
+}
+
+type instanceBody struct {
+	XMLName    xml.Name
+	XmlnsH     string `xml:"xmlns:h,attr"`
+	Properties []property
+}
+
+type property struct {
+	XMLName    xml.Name
+	Value      string               `xml:",chardata"`
+	Address    string               `xml:"wsa:Address,omitempty"`
+	Parameters *referenceParameters `xml:"wsa:ReferenceParameters,omitempty"`
+}
+
+type referenceParameters struct {
+	ResourceURI string       `xml:"wsman:ResourceURI"`
+	SelectorSet *selectorSet `xml:"wsman:SelectorSet,omitempty"`
+}
+
+type enumerateBody struct {
+	XMLName   xml.Name `xml:"wsen:Enumerate"`
+	XmlnsWsen string   `xml:"xmlns:wsen,attr"`
+}
+
+type pullBody struct {
+	XMLName            xml.Name `xml:"wsen:Pull"`
+	XmlnsWsen          string   `xml:"xmlns:wsen,attr"`
+	EnumerationContext string   `xml:"wsen:EnumerationContext"`
+	MaxElements        int      `xml:"wsen:MaxElements"`
+}
+
+// responseEnvelope decodes responses. Tags carry no namespace, so
+// elements match regardless of prefixes used by the firmware.
+type responseEnvelope struct {
+	Header struct {
+		Action    string `xml:"Action"`
+		MessageID string `xml:"MessageID"`
+	} `xml:"Header"`
+	Body struct {
+		Content []byte        `xml:",innerxml"`
+		Fault   *ErrSOAPFault `xml:"Fault"`
+	} `xml:"Body"`
+}
+
+type enumerationResponse struct {
+	EnumerationContext string    `xml:"EnumerationContext"`
+	Items              items     `xml:"Items"`
+	EndOfSequence      *struct{} `xml:"EndOfSequence"`
+}
+
+type items struct {
+	Content []byte `xml:",innerxml"`
+}
+
+// Envelope returns r as SOAP envelope addressed to given wsman URL.
+func (r Request) Envelope(to string) ([]byte, error) {
+	var e envelope
+	e.XmlnsS = nsSOAP
+	e.XmlnsWsa = nsAddressing
+	e.XmlnsWsm = nsWsman
+	e.Header.Action = mustUnderstand{r.Action, true}
+	e.Header.To = mustUnderstand{to, true}
+	e.Header.ResourceURI = mustUnderstand{r.ResourceURI, true}
+	e.Header.MessageID = mustUnderstand{newMessageID(), true}
+	e.Header.ReplyTo = addressAnonymous
+	if len(r.Selectors) > 0 {
+		e.Header.SelectorSet = &selectorSet{r.Selectors}
+	}
+	e.Body.Content = r.Body
+
+	data, err := xml.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+// InputBody returns a request body for invoking method of resourceURI.
+func InputBody(resourceURI string, method string, properties ...Property) interface{} {
+	return newInstanceBody(resourceURI, method+"_INPUT", properties)
+}
+
+// InstanceBody returns a request body representing an instance of
+// resourceURI, as used for Put.
+func InstanceBody(resourceURI string, properties ...Property) interface{} {
+	return newInstanceBody(resourceURI, className(resourceURI), properties)
+}
+
+func newInstanceBody(resourceURI string, name string, properties []Property) instanceBody {
+	b := instanceBody{XMLName: xml.Name{Local: "h:" + name}, XmlnsH: resourceURI}
+	for _, p := range properties {
+		prop := property{XMLName: xml.Name{Local: "h:" + p.Name}, Value: p.Value}
+		if p.Ref != nil {
+			prop.Value = ""
+			prop.Address = addressAnonymous
+			prop.Parameters = &referenceParameters{ResourceURI: p.Ref.ResourceURI}
+			if len(p.Ref.Selectors) > 0 {
+				prop.Parameters.SelectorSet = &selectorSet{p.Ref.Selectors}
+			}
+		}
+		b.Properties = append(b.Properties, prop)
+	}
+	return b
+}
+
+// Decode unmarshals the first element of the response body into v.
+// Struct tags of v should not use namespaces, so decoding does not
+// depend on prefixes used by the firmware. If v is an *Instance, the
+// body is decoded generically.
+func (r *Response) Decode(v interface{}) error {
+	if instance, ok := v.(*Instance); ok {
+		instances, err := parseInstances(r.Body)
+		if err != nil {
+			return err
+		}
+		if len(instances) == 0 {
+			return fmt.Errorf("empty response body")
+		}
+		*instance = instances[0]
+		return nil
+	}
+	return xml.Unmarshal(r.Body, v)
+}
+
+func parseResponse(statusCode int, data []byte) (*Response, error) {
+	response := &Response{StatusCode: statusCode}
+	var e responseEnvelope
+	if err := xml.Unmarshal(data, &e); err != nil {
+		return response, fmt.Errorf("cannot parse WS-MAN response: %s", err)
+	}
+	response.Action = strings.TrimSpace(e.Header.Action)
+	response.MessageID = strings.TrimSpace(e.Header.MessageID)
+	response.Body = bytes.TrimSpace(e.Body.Content)
+	if e.Body.Fault != nil {
+		return response, e.Body.Fault
+	}
+	return response, nil
+}
+
+// decodeItems unmarshals XML items as returned by enumerations into v.
+func decodeItems(data []byte, v interface{}) error {
+	wrapped := append([]byte("<Items>"), data...)
+	wrapped = append(wrapped, []byte("</Items>")...)
+	return xml.Unmarshal(wrapped, v)
+}
+
+func newMessageID() string {
+	u := make([]byte, 16)
+	rand.Read(u)
+	u[6] = (u[6] & 0x0f) | 0x40 // version 4
+	u[8] = (u[8] & 0x3f) | 0x80 // RFC 4122 variant
+	return fmt.Sprintf("uuid:%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:])
+}
+
+// className returns the class name part of a resource URI.
+func className(resourceURI string) string {
+	return resourceURI[strings.LastIndex(resourceURI, "/")+1:]
+}
+
+// localName strips a namespace prefix from a qualified name.
+func localName(qname string) string {
+	qname = strings.TrimSpace(qname)
+	return qname[strings.LastIndex(qname, ":")+1:]
+}
@@ -0,0 +1,168 @@
+package amt
+
+import (
+	"encoding/xml"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testResponseHeader = `<?xml version="1.0" encoding="UTF-8"?>
+<a:Envelope xmlns:a="http://www.w3.org/2003/05/soap-envelope" xmlns:b="http://schemas.xmlsoap.org/ws/2004/08/addressing" xmlns:g="http://schemas.xmlsoap.org/ws/2004/09/enumeration" xmlns:h="http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_AssociatedPowerManagementService">
+<a:Header><b:Action a:mustUnderstand="true">%ACTION%</b:Action></a:Header><a:Body>`
+
+const testResponseFooter = `</a:Body></a:Envelope>`
+
+func testResponse(action string, body string) string {
+	return strings.Replace(testResponseHeader, "%ACTION%", action, 1) + body + testResponseFooter
+}
+
+// fakeAMT starts a HTTP server answering WS-MAN requests using respond,
+// which gets the request's action and full envelope.
+func fakeAMT(t *testing.T, respond func(action string, envelope string) (int, string)) (*httptest.Server, *Client) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("cannot read request: %s", err)
+		}
+		var e struct {
+			Action string `xml:"Header>Action"`
+		}
+		if err = xml.Unmarshal(data, &e); err != nil {
+			t.Fatalf("cannot parse request: %s", err)
+		}
+		status, body := respond(e.Action, string(data))
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	client := NewClient("localhost", Optionset{OptTimeout: 5})
+	client.URL = server.URL + "/wsman"
+	return server, client
+}
+
+func TestRequestEnvelope(t *testing.T) {
+	data, err := requestPowerStateChange(requestPowerOn).Envelope("http://host:16992/wsman")
+	if err != nil {
+		t.Fatalf("Envelope failed: %s", err)
+	}
+	envelope := string(data)
+	for _, expected := range []string{
+		`<wsa:Action s:mustUnderstand="true">` + cimPowerManagementService + `/RequestPowerStateChange</wsa:Action>`,
+		`<wsa:To s:mustUnderstand="true">http://host:16992/wsman</wsa:To>`,
+		`<wsa:MessageID s:mustUnderstand="true">uuid:`,
+		`<wsman:Selector Name="SystemName">Intel(r) AMT</wsman:Selector>`,
+		`<h:RequestPowerStateChange_INPUT xmlns:h="` + cimPowerManagementService + `">`,
+		`<h:PowerState>2</h:PowerState>`,
+		`<h:ManagedElement><wsa:Address>` + addressAnonymous + `</wsa:Address><wsa:ReferenceParameters>`,
+		`<wsman:Selector Name="Name">ManagedSystem</wsman:Selector>`,
+	} {
+		if !strings.Contains(envelope, expected) {
+			t.Errorf("Envelope lacks %s:\n%s", expected, envelope)
+		}
+	}
+
+	data, _ = webUIStateChange(2).Envelope("http://host:16992/wsman")
+	if strings.Contains(string(data), "SelectorSet") {
+		t.Errorf("Envelope without selectors contains empty SelectorSet:\n%s", data)
+	}
+
+	first, _ := Request{Action: actionGet}.Envelope("")
+	second, _ := Request{Action: actionGet}.Envelope("")
+	if string(first) == string(second) {
+		t.Errorf("MessageID is not unique")
+	}
+}
+
+func TestPowerState(t *testing.T) {
+	server, client := fakeAMT(t, func(action string, envelope string) (int, string) {
+		switch action {
+		case actionEnumerate:
+			return 200, testResponse(nsEnumeration+"/EnumerateResponse",
+				`<g:EnumerateResponse><g:EnumerationContext>01000000-0000</g:EnumerationContext></g:EnumerateResponse>`)
+		case actionPull:
+			if !strings.Contains(envelope, "<wsen:EnumerationContext>01000000-0000</wsen:EnumerationContext>") {
+				t.Errorf("Pull lacks enumeration context:\n%s", envelope)
+			}
+			return 200, testResponse(nsEnumeration+"/PullResponse",
+				`<g:PullResponse><g:Items><h:CIM_AssociatedPowerManagementService>`+
+					`<h:PowerState>8</h:PowerState></h:CIM_AssociatedPowerManagementService></g:Items>`+
+					`<g:EndOfSequence></g:EndOfSequence></g:PullResponse>`)
+		}
+		t.Errorf("Unexpected action %s", action)
+		return 400, ""
+	})
+	defer server.Close()
+
+	state, err := client.PowerState()
+	if err != nil {
+		t.Fatalf("PowerState failed: %s", err)
+	}
+	if state != stateOffSoft {
+		t.Errorf("Expected power state %d, got %d", stateOffSoft, state)
+	}
+	if PowerStateText(state) != "Off-Soft" || PowerStateText(stateOffHard) != "Off-Hard" || PowerStateText(99) != "Unknown" {
+		t.Errorf("Unexpected power state texts")
+	}
+	if client.StatusCode != 200 {
+		t.Errorf("Expected status code 200, got %d", client.StatusCode)
+	}
+}
+
+func TestFault(t *testing.T) {
+	server, client := fakeAMT(t, func(action string, envelope string) (int, string) {
+		return 400, testResponse(nsAddressing+"/fault",
+			`<a:Fault><a:Code><a:Value>a:Sender</a:Value><a:Subcode><a:Value>b:DestinationUnreachable</a:Value></a:Subcode></a:Code>`+
+				`<a:Reason><a:Text xml:lang="en-US">No route can be determined to reach the destination role defined by the WS-Addressing To.</a:Text></a:Reason>`+
+				`<a:Detail><h:FaultDetail>http://schemas.dmtf.org/wbem/wsman/1/wsman/faultDetail/InvalidResourceURI</h:FaultDetail></a:Detail></a:Fault>`)
+	})
+	defer server.Close()
+
+	_, err := client.Send(Request{Action: actionGet, ResourceURI: uriAMT + "AMT_Nonexistent"})
+	var fault *ErrSOAPFault
+	if !errors.As(err, &fault) {
+		t.Fatalf("Expected *ErrSOAPFault error, got %v", err)
+	}
+	if fault.FaultCode() != "DestinationUnreachable" || !strings.HasSuffix(fault.Detail, "InvalidResourceURI") {
+		t.Errorf("Unexpected fault contents: %+v", fault)
+	}
+	if !strings.HasPrefix(err.Error(), "SOAP fault DestinationUnreachable: No route") {
+		t.Errorf("Unexpected fault message: %s", err)
+	}
+	if client.StatusCode != 400 {
+		t.Errorf("Expected status code 400, got %d", client.StatusCode)
+	}
+}
+
+func TestDigestClients(t *testing.T) {
+	url := "http://digest-test:16992/wsman"
+	options := Optionset{Username: "admin", Password: "old", OptTimeout: 5}
+	first, _ := digestClient(url, options)
+	if again, _ := digestClient(url, options); again != first {
+		t.Error("Expected digest client to be reused")
+	}
+	options.Password = "new"
+	rotated, _ := digestClient(url, options)
+	if rotated == first || rotated.Password != "new" {
+		t.Error("Expected digest client to be replaced after password change")
+	}
+
+	digestClientsLock.Lock()
+	defer digestClientsLock.Unlock()
+	count := 0
+	for key := range digestClients {
+		if key.url == url {
+			count++
+		}
+	}
+	if count != 1 {
+		t.Errorf("Expected a single digest client for %s, got %d", url, count)
+	}
+	sweepDigestClients(time.Now().Add(digestClientMaxIdle + time.Minute))
+	if len(digestClients) != 0 {
+		t.Errorf("Expected idle digest clients to be dropped, got %d", len(digestClients))
+	}
+}
@@ -0,0 +1,39 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/schnoddelbotz/amtgo/amt"
+)
+
+// GetAlertsJSON gets the most recent alerts pushed by all hosts
+func GetAlertsJSON() string {
+	var data amt.Alerts
+	db.Select(&data.Alerts, "SELECT * FROM alert ORDER BY alert_time DESC, id DESC LIMIT 100")
+	json, _ := json.Marshal(data)
+	return string(json)
+}
+
+// GetHostAlertsJSON gets the most recent alerts pushed by a single host
+func GetHostAlertsJSON(hostID int) string {
+	var data amt.Alerts
+	db.Select(&data.Alerts, "SELECT * FROM alert WHERE host_id=? ORDER BY alert_time DESC, id DESC LIMIT 100", hostID)
+	json, _ := json.Marshal(data)
+	return string(json)
+}
+
+// InsertAlert stores an alert pushed by a host and, if it deserves one,
+// adds a notification.
+func InsertAlert(host Host, alert amt.Alert) error {
+	_, err := db.Exec("INSERT INTO alert (host_id, alert_time, message_id, message, severity, category) "+
+		"VALUES (?,?,?,?,?,?)", host.ID, alert.AlertTime, alert.MessageID, alert.Message, alert.Severity, alert.Category)
+	if err != nil {
+		return err
+	}
+	if alert.Notify() {
+		InsertNotification(NotificationTypeWarning,
+			fmt.Sprintf("%s: %s alert: %s", host.Hostname, alert.Category, alert.Message))
+	}
+	return nil
+}
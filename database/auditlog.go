@@ -0,0 +1,73 @@
+package database
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/schnoddelbotz/amtgo/amt"
+)
+
+// GetAuditRecordsJSON gets the most recent AMT audit records of all hosts
+func GetAuditRecordsJSON() string {
+	var data amt.AuditRecords
+	db.Select(&data.AuditRecords, "SELECT * FROM auditlog ORDER BY event_time DESC, id DESC LIMIT 100")
+	json, _ := json.Marshal(data)
+	return string(json)
+}
+
+// GetAuditlogJSON gets all stored AMT audit records of a single host
+func GetAuditlogJSON(hostID int) string {
+	type auditlog struct {
+		ID      int               `json:"id"`
+		Records []amt.AuditRecord `json:"records"`
+	}
+	data := auditlog{ID: hostID, Records: GetAuditRecords(hostID)}
+	json, _ := json.Marshal(data)
+	return "{\"auditlog\":" + string(json) + "}"
+}
+
+// GetAuditlog gets all stored AMT audit records of a single host as AuditLog
+func GetAuditlog(hostID int) (log amt.AuditLog) {
+	db.Get(&log.Hostname, "SELECT hostname FROM host WHERE id=?", hostID)
+	if log.Hostname == "" {
+		log.Hostname = strconv.Itoa(hostID)
+	}
+	log.Records = GetAuditRecords(hostID)
+	return
+}
+
+// GetAuditRecords gets all stored AMT audit records of a single host
+func GetAuditRecords(hostID int) (records []amt.AuditRecord) {
+	db.Select(&records, "SELECT * FROM auditlog WHERE host_id=? ORDER BY event_time, id", hostID)
+	return
+}
+
+// InsertAuditRecords stores audit records of a host not stored yet.
+// It returns the number of records added.
+func InsertAuditRecords(hostID int, records []amt.AuditRecord) (added int, err error) {
+	var latest int
+	db.Get(&latest, "SELECT COALESCE(MAX(event_time), 0) FROM auditlog WHERE host_id=?", hostID)
+
+	for _, r := range records {
+		if r.Time < latest {
+			continue
+		}
+		if r.Time == latest {
+			var count int
+			db.Get(&count, "SELECT COUNT(*) FROM auditlog WHERE host_id=? AND record=?", hostID, r.Record)
+			if count > 0 {
+				continue
+			}
+		}
+		_, err = db.Exec("INSERT INTO auditlog (host_id, event_time, audit_app_id, event_id, audit_app, "+
+			"event, initiator_type, initiator, mc_location_type, net_address, extended_data, record) "+
+			"VALUES (?,?,?,?,?,?,?,?,?,?,?,?)",
+			hostID, r.Time, r.AuditAppID, r.EventID, r.AuditApp, r.Event, r.InitiatorType, r.Initiator,
+			r.MCLocationType, r.NetAddress, r.ExtendedData, r.Record)
+		if err != nil {
+			return
+		}
+		added++
+	}
+	return
+}
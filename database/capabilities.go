@@ -0,0 +1,39 @@
+package database
+
+import (
+	"fmt"
+
+	"github.com/schnoddelbotz/amtgo/amt"
+)
+
+// PowerCapabilitiesStore implements amt.PowerCapabilitiesStore using the
+// power_capabilities table. Hosts not in the database are not cached.
+type PowerCapabilitiesStore struct{}
+
+// GetPowerCapabilities gets the cached power capabilities of a host
+func (PowerCapabilitiesStore) GetPowerCapabilities(hostname string) (amt.PowerCapabilities, bool) {
+	var capabilities amt.PowerCapabilities
+	err := db.Get(&capabilities, "SELECT p.* FROM power_capabilities p JOIN host h ON h.id=p.host_id "+
+		"WHERE h.hostname=?", hostname)
+	return capabilities, err == nil
+}
+
+// SavePowerCapabilities replaces the cached power capabilities of a host
+func (PowerCapabilitiesStore) SavePowerCapabilities(hostname string, capabilities amt.PowerCapabilities) error {
+	if err := db.Get(&capabilities.HostID, "SELECT id FROM host WHERE hostname=?", hostname); err != nil {
+		return fmt.Errorf("host %s not found", hostname)
+	}
+	tx, err := db.Beginx()
+	if err != nil {
+		return err
+	}
+	tx.Exec("DELETE FROM power_capabilities WHERE host_id=?", capabilities.HostID)
+	_, err = tx.NamedExec("INSERT INTO power_capabilities (host_id, updated, amt_version, "+
+		"power_change_capabilities, power_states_supported) VALUES (:host_id, :updated, :amt_version, "+
+		":power_change_capabilities, :power_states_supported)", capabilities)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
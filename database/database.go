@@ -77,6 +77,7 @@ func OpenDBSQlite() {
 	}
 
 	db.Exec("PRAGMA foreign_keys = ON")
+	upgradeDB(sqliteUpgrades)
 }
 
 // OpenDBMySQL opens MySQL database
@@ -98,6 +99,18 @@ func OpenDBMySQL() {
 	if len(optionSets) == 0 {
 		InitDBMysql()
 	}
+	upgradeDB(mysqlUpgrades)
+}
+
+// upgradeDB applies schema upgrades. Errors for columns added
+// by previous runs are expected and ignored.
+func upgradeDB(statements []string) {
+	for _, statement := range statements {
+		_, err := db.Exec(statement)
+		if err != nil && !strings.Contains(strings.ToLower(err.Error()), "duplicate column") {
+			log.Printf("Error upgrading DB schema: %s", err)
+		}
+	}
 }
 
 // InitDBSQlite initializes DB schema
@@ -164,6 +177,12 @@ func GetUsersJSON() string {
 func GetHostsJSON() string {
 	var data Hosts
 	db.Select(&data.Hosts, "SELECT * FROM host ORDER BY hostname")
+	firmware := GetFirmware()
+	for i, host := range data.Hosts {
+		if f, ok := firmware[host.ID]; ok {
+			data.Hosts[i].Firmware = &f
+		}
+	}
 	json, _ := json.Marshal(data)
 	return string(json)
 }
@@ -214,6 +233,12 @@ func GetHostNamesByID(ids []string) (hostnames []string) {
 	return
 }
 
+// GetHostByName gets a single host by its hostname
+func GetHostByName(hostname string) (h Host, err error) {
+	err = db.Get(&h, "SELECT * FROM host WHERE hostname=?", hostname)
+	return
+}
+
 // GetHostsByOu gets all hosts of a OU.
 func GetHostsByOu(ou int) (hosts []Host) {
 	db.Select(&hosts, "SELECT * FROM host WHERE ou_id = ?", ou)
@@ -226,6 +251,12 @@ func GetOus() (ous []Ou) {
 	return
 }
 
+// GetOuByName gets a single OU by name
+func GetOuByName(name string) (o Ou, err error) {
+	err = db.Get(&o, "SELECT * FROM ou WHERE name=?", name)
+	return
+}
+
 // GetLogdaysJSON gets all Logdays
 func GetLogdaysJSON() string {
 	type logday struct {
@@ -255,6 +286,9 @@ func GetStatelogsJSON(ouid int, unixtime int) []byte {
 			data = append(data, entry)
 		}
 	}
+	for i := range data {
+		data[i].StatePowerText = amt.PowerStateText(data[i].StatePower)
+	}
 	json, _ := json.Marshal(data)
 	return json
 }
@@ -301,6 +335,9 @@ func GetUser(name string) (u User) {
 func GetHostJSON(id int) string {
 	data := Host{}
 	db.Get(&data, "SELECT * FROM host WHERE id=?", id)
+	if f, ok := GetFirmware()[id]; ok {
+		data.Firmware = &f
+	}
 	json, _ := json.Marshal(data)
 	return "{\"host\":" + string(json) + "}"
 }
@@ -335,9 +372,33 @@ func GetJobJSON(id int) string {
 	return "{\"job\":" + string(json) + "}"
 }
 
+// GetJob gets a single job
+func GetJob(id int) (j Job) {
+	db.Get(&j, "SELECT * FROM job WHERE id=?", id)
+	return
+}
+
 // GetScheduledJobs gets all scheduled jobs for a given weekday and minute of day.
 func GetScheduledJobs(weekDay int, minuteOfDay int) (myjobs []Job) {
-	db.Select(&myjobs, "SELECT * FROM job WHERE job_type=2 AND repeat_days & ? == ? AND start_time = ?", weekDay, weekDay, minuteOfDay)
+	db.Select(&myjobs, "SELECT * FROM job WHERE job_type=2 AND alarm_clock=0 AND (repeat_days & ?) = ? AND start_time = ?", weekDay, weekDay, minuteOfDay)
+	return
+}
+
+// GetScheduledAlarmClockJobs gets all alarm clock jobs for a given weekday and minute of day.
+func GetScheduledAlarmClockJobs(weekDay int, minuteOfDay int) (myjobs []Job) {
+	db.Select(&myjobs, "SELECT * FROM job WHERE job_type=2 AND alarm_clock=1 AND (repeat_days & ?) = ? AND start_time = ?", weekDay, weekDay, minuteOfDay)
+	return
+}
+
+// GetAlarmClockJobs gets all scheduled jobs run by hosts' AMT alarm clocks.
+func GetAlarmClockJobs() (myjobs []Job) {
+	db.Select(&myjobs, "SELECT * FROM job WHERE job_type=2 AND alarm_clock=1")
+	return
+}
+
+// GetTimesyncJobs gets all time synchronization jobs.
+func GetTimesyncJobs() (myjobs []Job) {
+	db.Select(&myjobs, "SELECT * FROM job WHERE job_type=?", JobTypeTimesync)
 	return
 }
 
@@ -429,6 +490,9 @@ func InsertUser(u User) {
 func InsertNotification(ntype string, message string) {
 	// hack: user_id refs valid user but GUI doesn't give it.
 	users := GetUsers()
+	if len(users) == 0 {
+		return // not configured yet, e.g. alerts received before setup
+	}
 	userid := users[0].ID
 	db.Exec("INSERT INTO notification (ntype,message,user_id) VALUES (?,?,?)",
 		ntype, message, userid)
@@ -506,19 +570,26 @@ func InsertOptionset(body io.ReadCloser) string {
 		opt.SwSkipcertchk = 1
 	}
 
+	if submitted.OptUsername != nil {
+		opt.Username = *submitted.OptUsername
+	}
+	if submitted.SwFallback != nil && *submitted.SwFallback {
+		opt.SwFallback = 1
+	}
+
 	fields := "name,description,sw_scan22,sw_scan3389,sw_usetls," +
-		"sw_skipcertchk,opt_timeout,opt_passfile,opt_cacertfile"
-	q, _ := db.Exec("INSERT INTO optionset ("+fields+") VALUES (?,?,?,?,?,?,?,?,?)",
+		"sw_skipcertchk,opt_timeout,opt_passfile,opt_cacertfile,opt_username,sw_fallback"
+	q, _ := db.Exec("INSERT INTO optionset ("+fields+") VALUES (?,?,?,?,?,?,?,?,?,?,?)",
 		opt.Name, opt.Description, opt.SwScan22, opt.SwScan3389, opt.SwUseTLS,
-		opt.SwSkipcertchk, opt.OptTimeout, opt.OptPassfile, opt.OptCacertfile)
+		opt.SwSkipcertchk, opt.OptTimeout, opt.OptPassfile, opt.OptCacertfile, opt.Username, opt.SwFallback)
 	id, _ := q.LastInsertId()
 	return GetOptionsetJSON(int(id))
 }
 
 // InsertStatelog adds a record in statelog table
-func InsertStatelog(hostid int, http int, amt int, port int) {
-	db.Exec("INSERT INTO statelog (host_id, state_http, state_amt, open_port) VALUES (?,?,?,?)",
-		hostid, http, amt, port)
+func InsertStatelog(hostid int, http int, amt int, port int, power int) {
+	db.Exec("INSERT INTO statelog (host_id, state_http, state_amt, open_port, state_power) VALUES (?,?,?,?,?)",
+		hostid, http, amt, port, power)
 }
 
 // InsertJob inserts a (scheduled) job record
@@ -526,8 +597,8 @@ func InsertJob(j Job) string {
 	// hack: user_id refs valid user but GUI doesn't give it.
 	users := GetUsers()
 	userid := users[0].ID
-	q, e := db.Exec("INSERT INTO job (job_type,user_id,amtc_cmd,amtc_delay,ou_id,start_time,repeat_days,description) VALUES (?,?,?,?,?,?,?,?)",
-		j.JobType, userid, j.AmtcCmd, j.AmtcDelay, j.OuID, j.StartTime, j.RepeatDays, j.Description)
+	q, e := db.Exec("INSERT INTO job (job_type,user_id,amtc_cmd,amtc_delay,amtc_bootdevice,ou_id,start_time,repeat_interval,repeat_days,description,alarm_clock) VALUES (?,?,?,?,?,?,?,?,?,?,?)",
+		j.JobType, userid, j.AmtcCmd, j.AmtcDelay, j.AmtcBootdevice, j.OuID, j.StartTime, j.RepeatInterval, j.RepeatDays, j.Description, j.AlarmClock)
 	if e != nil {
 		log.Printf("New scheduled job error: %s", e)
 		return "{}"
@@ -540,14 +611,39 @@ func InsertJob(j Job) string {
 
 // UpdateJob updates a (scheduled) job record
 func UpdateJob(j Job) string {
-	_, e := db.Exec("UPDATE job SET job_type=?, amtc_cmd=?, amtc_delay=?, ou_id=?, start_time=?, repeat_days=?, description=? WHERE id=?",
-		j.JobType, j.AmtcCmd, j.AmtcDelay, j.OuID, j.StartTime, j.RepeatDays, j.Description, j.ID)
+	_, e := db.Exec("UPDATE job SET job_type=?, amtc_cmd=?, amtc_delay=?, amtc_bootdevice=?, ou_id=?, start_time=?, repeat_interval=?, repeat_days=?, description=?, alarm_clock=? WHERE id=?",
+		j.JobType, j.AmtcCmd, j.AmtcDelay, j.AmtcBootdevice, j.OuID, j.StartTime, j.RepeatInterval, j.RepeatDays, j.Description, j.AlarmClock, j.ID)
 	if e != nil {
 		log.Printf("E: %s", e.Error())
 	}
 	return GetJobJSON(j.ID)
 }
 
+// UpdateOuOptionset sets the optionset of a OU
+func UpdateOuOptionset(ouID int, optionsetID int) error {
+	_, err := db.Exec("UPDATE ou SET optionset_id=? WHERE id=?", optionsetID, ouID)
+	return err
+}
+
+// UpdateJobAlarmClock sets whether a job is run by hosts' AMT alarm clocks
+func UpdateJobAlarmClock(id int, alarmClock int) error {
+	_, err := db.Exec("UPDATE job SET alarm_clock=? WHERE id=?", alarmClock, id)
+	return err
+}
+
+// UpdateJobRun stores start and end time of a job's last run
+func UpdateJobRun(id int, lastStarted int, lastDone int) error {
+	_, err := db.Exec("UPDATE job SET last_started=?, last_done=? WHERE id=?", lastStarted, lastDone, id)
+	return err
+}
+
+// UpdateHostNetwork stores MAC, IP address and subnet mask of a host's AMT interface
+func UpdateHostNetwork(hostID int, macAddress string, ipAddress string, subnetMask string) error {
+	_, err := db.Exec("UPDATE host SET mac_address=?, ip_address=?, subnet_mask=? WHERE id=?",
+		macAddress, ipAddress, subnetMask, hostID)
+	return err
+}
+
 // UpdateOu updates a OU record
 func UpdateOu(id int, body io.ReadCloser) string {
 	decoder := json.NewDecoder(body)
@@ -619,5 +715,14 @@ func UpdateOptionset(id int, body io.ReadCloser) string {
 		"WHERE id=?",
 		opt.Name, opt.Description, opt.SwScan22, opt.SwScan3389, opt.SwUseTLS,
 		opt.SwSkipcertchk, opt.OptTimeout, opt.OptPassfile, opt.OptCacertfile, id)
+	if submitted.OptUsername != nil {
+		db.Exec("UPDATE optionset SET opt_username=? WHERE id=?", *submitted.OptUsername, id)
+	}
+	if submitted.SwFallback != nil {
+		if *submitted.SwFallback {
+			opt.SwFallback = 1
+		}
+		db.Exec("UPDATE optionset SET sw_fallback=? WHERE id=?", opt.SwFallback, id)
+	}
 	return GetOptionsetJSON(id)
 }
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/schnoddelbotz/amtgo/amt"
 )
@@ -142,3 +144,234 @@ func TestOptionset(t *testing.T) {
 		t.Error("Deletion of Optionset was reported successful, but that was a lie")
 	}
 }
+
+func TestInventory(t *testing.T) {
+	// host 1 is part of default schema
+	inventory := amt.NewInventory(map[string][]amt.Instance{
+		"CIM_PhysicalMemory": {
+			{Class: "CIM_PhysicalMemory", Properties: []amt.Property{{Name: "Capacity", Value: "4294967296"}}},
+			{Class: "CIM_PhysicalMemory", Properties: []amt.Property{{Name: "Capacity", Value: "4294967296"}}},
+		},
+	})
+	inventory.HostID = 1
+	if err := SaveInventory(inventory); err != nil {
+		t.Fatalf("SaveInventory failed: %s", err)
+	}
+	// saving twice replaces previous inventory
+	if err := SaveInventory(inventory); err != nil {
+		t.Fatalf("SaveInventory failed: %s", err)
+	}
+
+	stored := GetInventory(1)
+	if stored.MemoryMB != 8192 || stored.MemoryModules != 2 {
+		t.Errorf("Stored inventory hasn't desired content: %+v", stored)
+	}
+	if len(stored.Items["CIM_PhysicalMemory"]) != 2 || stored.Items["CIM_PhysicalMemory"][1].Get("Capacity") != "4294967296" {
+		t.Errorf("Stored inventory items haven't desired content: %+v", stored.Items)
+	}
+
+	var all amt.Inventories
+	if err := json.Unmarshal([]byte(GetInventoriesJSON()), &all); err != nil || len(all.Inventories) != 1 {
+		t.Errorf("Unexpected inventories: %+v (%v)", all, err)
+	}
+}
+
+func TestEvents(t *testing.T) {
+	first := amt.Event{Time: 100, EventSensorType: 15, Description: "first", Record: "01"}
+	second := amt.Event{Time: 200, EventSensorType: 15, Description: "second", Record: "02"}
+	third := amt.Event{Time: 200, EventSensorType: 15, Description: "third", Record: "03"}
+
+	if added, err := InsertEvents(2, []amt.Event{first, second}); added != 2 || err != nil {
+		t.Errorf("Expected 2 new events, got %d (%v)", added, err)
+	}
+	// already stored records are skipped
+	if added, err := InsertEvents(2, []amt.Event{first, second, third}); added != 1 || err != nil {
+		t.Errorf("Expected 1 new event, got %d (%v)", added, err)
+	}
+	events := GetEvents(2)
+	if len(events) != 3 || events[2].Description != "third" || events[0].HostID != 2 {
+		t.Errorf("Unexpected stored events: %+v", events)
+	}
+}
+
+func TestAuditRecords(t *testing.T) {
+	first := amt.AuditRecord{Time: 100, Event: "Performed Power Up", Initiator: "admin", Record: "AQ=="}
+	second := amt.AuditRecord{Time: 200, Event: "Performed Reset", Initiator: "operator", Record: "Ag=="}
+
+	if added, err := InsertAuditRecords(2, []amt.AuditRecord{first}); added != 1 || err != nil {
+		t.Errorf("Expected 1 new audit record, got %d (%v)", added, err)
+	}
+	if added, err := InsertAuditRecords(2, []amt.AuditRecord{first, second}); added != 1 || err != nil {
+		t.Errorf("Expected 1 new audit record, got %d (%v)", added, err)
+	}
+	log := GetAuditlog(2)
+	if len(log.Records) != 2 || log.Records[1].Initiator != "operator" || log.Records[0].HostID != 2 {
+		t.Errorf("Unexpected stored audit records: %+v", log.Records)
+	}
+}
+
+func TestHostNetwork(t *testing.T) {
+	// host 1 is part of default schema
+	if err := UpdateHostNetwork(1, "00-1a-2b-3c-4d-5e", "192.168.1.10", "255.255.255.0"); err != nil {
+		t.Fatalf("UpdateHostNetwork failed: %s", err)
+	}
+	for _, host := range GetHosts() {
+		if host.ID == 1 && (host.MacAddress != "00-1a-2b-3c-4d-5e" || host.IPAddress != "192.168.1.10") {
+			t.Errorf("Stored host hasn't desired network: %+v", host)
+		}
+	}
+}
+
+func TestPasswordRotation(t *testing.T) {
+	// host 1 and optionset 1 are part of default schema
+	rotation := PasswordRotation{HostID: 1, OptionsetID: 1, Passfile: "/tmp/new-pass", State: RotationPending}
+	if err := SavePasswordRotation(rotation); err != nil {
+		t.Fatalf("SavePasswordRotation failed: %s", err)
+	}
+	rotation.State = RotationRotated
+	if err := SavePasswordRotation(rotation); err != nil {
+		t.Fatalf("SavePasswordRotation failed: %s", err)
+	}
+	rotations := GetPasswordRotations(1)
+	if len(rotations) != 1 || rotations[0].State != RotationRotated || rotations[0].Updated == 0 {
+		t.Errorf("Unexpected stored rotations: %+v", rotations)
+	}
+
+	if err := FinishPasswordRotation(1, "/tmp/new-pass"); err != nil {
+		t.Fatalf("FinishPasswordRotation failed: %s", err)
+	}
+	if GetOptionset(1).OptPassfile != "/tmp/new-pass" || len(GetPasswordRotations(1)) != 0 {
+		t.Errorf("Optionset wasn't switched to new password file: %+v", GetOptionset(1))
+	}
+}
+
+func TestTLSOptionset(t *testing.T) {
+	base := GetOptionset(1)
+	id, err := GetTLSOptionset(base, "/etc/amtgo/ca.pem")
+	if err != nil {
+		t.Fatalf("GetTLSOptionset failed: %s", err)
+	}
+	created := GetOptionset(id)
+	if created.SwUseTLS != 1 || created.SwSkipcertchk != 0 || created.OptCacertfile != "/etc/amtgo/ca.pem" ||
+		created.OptPassfile != base.OptPassfile {
+		t.Errorf("Unexpected TLS optionset: %+v", created)
+	}
+	// existing optionset is reused
+	if again, err := GetTLSOptionset(base, "/etc/amtgo/ca.pem"); again != id || err != nil {
+		t.Errorf("Expected optionset %d to be reused, got %d (%v)", id, again, err)
+	}
+}
+
+func TestPowerCapabilitiesStore(t *testing.T) {
+	store := PowerCapabilitiesStore{}
+	hostname := GetHosts()[0].Hostname
+	capabilities := amt.PowerCapabilities{Updated: 1, AmtVersion: "8.1.40", PowerStates: "2,8,10"}
+	if err := store.SavePowerCapabilities(hostname, capabilities); err != nil {
+		t.Fatalf("SavePowerCapabilities failed: %s", err)
+	}
+	// saving twice replaces previous capabilities
+	capabilities.AmtVersion = "11.0.25"
+	if err := store.SavePowerCapabilities(hostname, capabilities); err != nil {
+		t.Fatalf("SavePowerCapabilities failed: %s", err)
+	}
+	stored, ok := store.GetPowerCapabilities(hostname)
+	if !ok || stored.AmtVersion != "11.0.25" || stored.PowerStates != "2,8,10" {
+		t.Errorf("Stored power capabilities haven't desired content: %+v", stored)
+	}
+	if store.SavePowerCapabilities("no-such-host", capabilities) == nil {
+		t.Errorf("Expected error saving capabilities of unknown host")
+	}
+}
+
+func TestStatelogPowerState(t *testing.T) {
+	// host 1 (OU 4) is part of default schema
+	InsertStatelog(1, 200, 9, 0, 6)
+	data := string(GetStatelogsJSON(4, int(time.Now().Unix())))
+	if !strings.Contains(data, `"state_power":6,"state_power_text":"Off-Hard"`) {
+		t.Errorf("Statelogs lack CIM power state: %s", data)
+	}
+}
+
+func TestInsertAlert(t *testing.T) {
+	host, err := GetHostByName("labpc-e19-02")
+	if err != nil {
+		t.Fatalf("GetHostByName failed: %s", err)
+	}
+	alert := amt.Alert{AlertTime: int(time.Now().Unix()), MessageID: "iAMT0005",
+		Message: "General Chassis Intrusion", Severity: 2, Category: amt.AlertChassisIntrusion}
+	InsertUser(User{Name: "alerts", Fullname: "Alert Receiver"})
+	defer DeleteUser(GetUser("alerts").ID)
+	if err = InsertAlert(host, alert); err != nil {
+		t.Fatalf("InsertAlert failed: %s", err)
+	}
+	if data := GetHostAlertsJSON(host.ID); !strings.Contains(data, `"message_id":"iAMT0005"`) {
+		t.Errorf("Alerts lack inserted alert: %s", data)
+	}
+	if data := GetNotificationsJSON(); !strings.Contains(data, "labpc-e19-02: chassis-intrusion alert") {
+		t.Errorf("Notifications lack alert: %s", data)
+	}
+}
+
+func TestAlarmClockJobs(t *testing.T) {
+	cmd, delay, ou, days := "U", 1.0, 4, 127
+	description := "Power-Up by alarm clock"
+	InsertUser(User{Name: "alarms", Fullname: "Alarm Clock"})
+	defer DeleteUser(GetUser("alarms").ID)
+	InsertJob(Job{JobType: 2, AmtcCmd: &cmd, AmtcDelay: &delay, OuID: &ou, StartTime: 420,
+		RepeatDays: &days, Description: &description, AlarmClock: 1})
+
+	jobs := GetAlarmClockJobs()
+	if len(jobs) != 1 || *jobs[0].Description != description {
+		t.Fatalf("Unexpected alarm clock jobs %+v", jobs)
+	}
+	defer DeleteJob(jobs[0].ID)
+	if scheduled := GetScheduledJobs(1, 420); len(scheduled) != 0 {
+		t.Errorf("Alarm clock job must not be run by amtgo: %+v", scheduled)
+	}
+	if scheduled := GetScheduledAlarmClockJobs(1, 420); len(scheduled) != 1 {
+		t.Errorf("Expected scheduled alarm clock job, got %+v", scheduled)
+	}
+	if err := UpdateJobAlarmClock(jobs[0].ID, 0); err != nil || GetJob(jobs[0].ID).AlarmClock != 0 {
+		t.Errorf("Cannot disable alarm clock of job: %v", err)
+	}
+}
+
+func TestWakeTargetStore(t *testing.T) {
+	// host 1 (OU 4) is part of default schema
+	UpdateHostNetwork(1, "00-1a-2b-3c-4d-5e", "10.1.19.42", "255.255.255.0")
+	store := WakeTargetStore{}
+	target, ok := store.GetWakeTarget("labpc-e19-01")
+	if !ok || target.MACAddress != "00-1a-2b-3c-4d-5e" || target.Broadcast != "10.1.19.255" || target.Only {
+		t.Errorf("Unexpected wake target %+v", target)
+	}
+	if err := UpdateHostWakeOnLAN("labpc-e19-01", "", "10.1.255.255", true); err != nil {
+		t.Fatalf("UpdateHostWakeOnLAN failed: %s", err)
+	}
+	target, _ = store.GetWakeTarget("labpc-e19-01")
+	if target.Broadcast != "10.1.255.255" || !target.Only {
+		t.Errorf("Unexpected wake target %+v", target)
+	}
+	if UpdateHostWakeOnLAN("labpc-e19-01", "no-mac", "", false) == nil {
+		t.Errorf("Expected error for invalid MAC address")
+	}
+	UpdateHostWakeOnLAN("labpc-e19-01", "", "", false)
+}
+
+func TestHostFirmware(t *testing.T) {
+	// host 3 is part of default schema
+	firmware := amt.Firmware{HostID: 3, Updated: int(time.Now().Unix()), AmtVersion: "9.1.20",
+		AmtBuild: "1000", ProvisioningMode: "ACM", ProvisioningState: "post"}
+	if err := SaveFirmware(firmware); err != nil {
+		t.Fatalf("SaveFirmware failed: %s", err)
+	}
+	if data := GetHostJSON(3); !strings.Contains(data, `"amt_version":"9.1.20","amt_build":"1000"`) {
+		t.Errorf("Host lacks firmware: %s", data)
+	}
+	if data := GetHostsJSON(); !strings.Contains(data, `"provisioning_mode":"ACM"`) {
+		t.Errorf("Hosts lack firmware: %s", data)
+	}
+	// 9.1.20 predates the INTEL-SA-00075 fix 9.1.41.3024, and later ones
+	if data := GetFirmwareAuditJSON(3); !strings.Contains(data, `"amt_version":"9.1.20.1000","advisories":["INTEL-SA-00075","INTEL-SA-00086"`) {
+		t.Errorf("Firmware audit lacks advisory: %s", data)
+	}
+}
@@ -0,0 +1,63 @@
+package database
+
+import (
+	"encoding/json"
+
+	"github.com/schnoddelbotz/amtgo/amt"
+)
+
+// GetEventsJSON gets the most recent AMT events of all hosts
+func GetEventsJSON() string {
+	var data amt.Events
+	db.Select(&data.Events, "SELECT * FROM eventlog ORDER BY event_time DESC, id DESC LIMIT 100")
+	json, _ := json.Marshal(data)
+	return string(json)
+}
+
+// GetEventlogJSON gets all stored AMT events of a single host
+func GetEventlogJSON(hostID int) string {
+	type eventlog struct {
+		ID     int         `json:"id"`
+		Events []amt.Event `json:"events"`
+	}
+	data := eventlog{ID: hostID, Events: GetEvents(hostID)}
+	json, _ := json.Marshal(data)
+	return "{\"eventlog\":" + string(json) + "}"
+}
+
+// GetEvents gets all stored AMT events of a single host
+func GetEvents(hostID int) (events []amt.Event) {
+	db.Select(&events, "SELECT * FROM eventlog WHERE host_id=? ORDER BY event_time, id", hostID)
+	return
+}
+
+// InsertEvents stores events of a host not stored yet.
+// It returns the number of events added.
+func InsertEvents(hostID int, events []amt.Event) (added int, err error) {
+	var latest int
+	db.Get(&latest, "SELECT COALESCE(MAX(event_time), 0) FROM eventlog WHERE host_id=?", hostID)
+
+	for _, e := range events {
+		if e.Time < latest {
+			continue
+		}
+		if e.Time == latest {
+			var count int
+			db.Get(&count, "SELECT COUNT(*) FROM eventlog WHERE host_id=? AND record=?", hostID, e.Record)
+			if count > 0 {
+				continue
+			}
+		}
+		_, err = db.Exec("INSERT INTO eventlog (host_id, event_time, device_address, event_sensor_type, "+
+			"event_type, event_offset, event_source_type, event_severity, sensor_number, entity, "+
+			"entity_instance, event_data, description, record) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?)",
+			hostID, e.Time, e.DeviceAddress, e.EventSensorType, e.EventType, e.EventOffset,
+			e.EventSourceType, e.EventSeverity, e.SensorNumber, e.Entity, e.EntityInstance,
+			e.EventData, e.Description, e.Record)
+		if err != nil {
+			return
+		}
+		added++
+	}
+	return
+}
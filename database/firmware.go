@@ -0,0 +1,72 @@
+package database
+
+import (
+	"encoding/json"
+
+	"github.com/schnoddelbotz/amtgo/amt"
+)
+
+// GetFirmware gets the stored AMT firmware of all hosts, by host ID
+func GetFirmware() map[int]amt.Firmware {
+	var records []amt.Firmware
+	db.Select(&records, "SELECT * FROM firmware")
+	firmware := make(map[int]amt.Firmware)
+	for _, f := range records {
+		firmware[f.HostID] = f
+	}
+	return firmware
+}
+
+// SaveFirmware replaces the stored AMT firmware of a host
+func SaveFirmware(firmware amt.Firmware) error {
+	tx, err := db.Beginx()
+	if err != nil {
+		return err
+	}
+	tx.Exec("DELETE FROM firmware WHERE host_id=?", firmware.HostID)
+	_, err = tx.NamedExec("INSERT INTO firmware (host_id, updated, amt_version, amt_build, amt_sku, "+
+		"provisioning_mode, provisioning_state, amt_hostname, amt_domain, digest_realm) VALUES (:host_id, "+
+		":updated, :amt_version, :amt_build, :amt_sku, :provisioning_mode, :provisioning_state, "+
+		":amt_hostname, :amt_domain, :digest_realm)", firmware)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// GetFirmwareAudits gets advisories affecting the stored AMT firmware of all hosts
+func GetFirmwareAudits() (audits []amt.FirmwareAudit) {
+	var hosts []Host
+	db.Select(&hosts, "SELECT * FROM host ORDER BY hostname")
+	firmware := GetFirmware()
+	for _, host := range hosts {
+		if f, ok := firmware[host.ID]; ok {
+			audits = append(audits, amt.FirmwareAudit{HostID: host.ID, Hostname: host.Hostname,
+				AmtVersion: f.Version(), Advisories: amt.AdvisoryIDs(f.Version())})
+		}
+	}
+	return
+}
+
+// GetFirmwareAuditsJSON gets advisories and the stored AMT firmware audits of all hosts
+func GetFirmwareAuditsJSON() string {
+	data := amt.FirmwareAudits{Advisories: amt.Advisories, FirmwareAudits: GetFirmwareAudits()}
+	json, _ := json.Marshal(data)
+	return string(json)
+}
+
+// GetFirmwareAuditJSON gets advisories affecting the stored AMT firmware of a single host
+func GetFirmwareAuditJSON(hostID int) string {
+	data := amt.FirmwareAudits{Advisories: []amt.Advisory{}, FirmwareAudits: []amt.FirmwareAudit{}}
+	for _, audit := range GetFirmwareAudits() {
+		if audit.HostID == hostID {
+			data.FirmwareAudits = append(data.FirmwareAudits, audit)
+			if matches := amt.MatchAdvisories(audit.AmtVersion); matches != nil {
+				data.Advisories = matches
+			}
+		}
+	}
+	json, _ := json.Marshal(data)
+	return string(json)
+}
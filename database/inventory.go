@@ -0,0 +1,78 @@
+package database
+
+import (
+	"encoding/json"
+	"log"
+
+	"github.com/schnoddelbotz/amtgo/amt"
+)
+
+// GetInventoriesJSON gets inventory summaries of all hosts
+func GetInventoriesJSON() string {
+	var data amt.Inventories
+	db.Select(&data.Inventories, "SELECT * FROM inventory ORDER BY host_id")
+	json, _ := json.Marshal(data)
+	return string(json)
+}
+
+// GetInventoryJSON gets the inventory of a single host, including items
+func GetInventoryJSON(hostID int) string {
+	data := GetInventory(hostID)
+	json, _ := json.Marshal(data)
+	return "{\"inventory\":" + string(json) + "}"
+}
+
+// GetInventory gets the inventory of a single host, including items
+func GetInventory(hostID int) (inventory amt.Inventory) {
+	db.Get(&inventory, "SELECT * FROM inventory WHERE host_id=?", hostID)
+
+	type item struct {
+		Class string
+		Data  string
+	}
+	var items []item
+	db.Select(&items, "SELECT class, data FROM inventory_item WHERE host_id=?", hostID)
+	for _, i := range items {
+		properties, err := amt.ParseProperties([]byte(i.Data))
+		if err != nil {
+			log.Printf("Invalid inventory item of host %d: %s", hostID, err)
+			continue
+		}
+		if inventory.Items == nil {
+			inventory.Items = make(map[string][]amt.Instance)
+		}
+		inventory.Items[i.Class] = append(inventory.Items[i.Class],
+			amt.Instance{Class: i.Class, ResourceURI: amt.ResourceURI(i.Class), Properties: properties})
+	}
+	return
+}
+
+// SaveInventory replaces the stored inventory of a host
+func SaveInventory(inventory amt.Inventory) error {
+	tx, err := db.Beginx()
+	if err != nil {
+		return err
+	}
+	tx.Exec("DELETE FROM inventory WHERE host_id=?", inventory.HostID)
+	tx.Exec("DELETE FROM inventory_item WHERE host_id=?", inventory.HostID)
+	_, err = tx.NamedExec("INSERT INTO inventory (host_id, updated, manufacturer, model, serial_number, "+
+		"platform_guid, bios_vendor, bios_version, bios_date, cpu_count, cpu_max_speed, memory_mb, "+
+		"memory_modules, amt_version) VALUES (:host_id, :updated, :manufacturer, :model, :serial_number, "+
+		":platform_guid, :bios_vendor, :bios_version, :bios_date, :cpu_count, :cpu_max_speed, :memory_mb, "+
+		":memory_modules, :amt_version)", inventory)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	for class, instances := range inventory.Items {
+		for _, instance := range instances {
+			data, _ := json.Marshal(instance)
+			if _, err = tx.Exec("INSERT INTO inventory_item (host_id, class, data) VALUES (?,?,?)",
+				inventory.HostID, class, string(data)); err != nil {
+				tx.Rollback()
+				return err
+			}
+		}
+	}
+	return tx.Commit()
+}
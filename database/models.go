@@ -1,5 +1,7 @@
 package database
 
+import "github.com/schnoddelbotz/amtgo/amt"
+
 const (
 	// NotificationTypeUser maps to user symbol
 	NotificationTypeUser = "user"
@@ -67,6 +69,18 @@ type Host struct {
 	OuID     int    `json:"ou_id" db:"ou_id"`
 	Hostname string `json:"hostname"`
 	Enabled  int    `json:"enabled"`
+
+	// of the wired AMT interface, as last seen by CollectInventory
+	MacAddress string `json:"mac_address" db:"mac_address"`
+	IPAddress  string `json:"ip_address" db:"ip_address"`
+	SubnetMask string `json:"subnet_mask" db:"subnet_mask"`
+
+	// Wake-on-LAN: broadcast address overriding the subnet's, WoL only (no usable AMT)
+	WolBroadcast string `json:"wol_broadcast" db:"wol_broadcast"`
+	WolOnly      int    `json:"wol_only" db:"wol_only"`
+
+	// AMT firmware and provisioning, as last seen by CollectInventory
+	Firmware *amt.Firmware `json:"firmware,omitempty" db:"-"`
 }
 
 // Hosts array for ember
@@ -89,6 +103,10 @@ type Statelog struct {
 	OpenPort   int `json:"open_port" db:"open_port"`
 	StateAMT   int `json:"state_amt" db:"state_amt"`
 	StateHTTP  int `json:"state_http" db:"state_http"`
+
+	// amtgo only: CIM PowerState, see amt.Laststate
+	StatePower     int    `json:"state_power" db:"state_power"`
+	StatePowerText string `json:"state_power_text" db:"-"`
 }
 
 // Statelogs wraps statelogs for json/emberjs
@@ -96,6 +114,27 @@ type Statelogs struct {
 	Statelogs []Statelog `json:"statelogs"`
 }
 
+// PasswordRotation states
+const (
+	RotationPending = "pending"
+	RotationRotated = "rotated"
+	RotationFailed  = "failed"
+)
+
+// PasswordRotation is the state of a host within an unfinished
+// AMT admin password rotation of an optionset
+type PasswordRotation struct {
+	HostID      int    `json:"host_id" db:"host_id"`
+	OptionsetID int    `json:"optionset_id" db:"optionset_id"`
+	Passfile    string `json:"passfile"`
+	State       string `json:"state"`
+	Message     string `json:"message"`
+	Updated     int    `json:"updated"`
+}
+
+// JobTypeTimesync jobs synchronize AMT clocks of all monitored OUs every repeat_interval minutes
+const JobTypeTimesync = 4
+
 // Job is a scheduled job
 type Job struct {
 	ID             int      `json:"id"`
@@ -114,6 +153,9 @@ type Job struct {
 	LastDone       *int     `json:"last_done" db:"last_done"`
 	ProcPid        *int     `json:"proc_pid" db:"proc_pid"`
 	Description    *string  `json:"description"`
+
+	// amtgo only: power-up job pushed into hosts' AMT alarm clocks, see scheduler.PushAlarms
+	AlarmClock int `json:"alarm_clock" db:"alarm_clock"`
 }
 
 // Jobs for ember
@@ -135,6 +177,9 @@ type emberOptionset struct {
 	OptTimeout    string `json:"opt_timeout"` // int
 	OptPassfile   string `json:"opt_passfile"`
 	OptCacertfile string `json:"opt_cacertfile"`
+
+	OptUsername *string `json:"username"`    // amtgo only: not sent by GUI, kept if missing
+	SwFallback  *bool   `json:"sw_fallback"` // amtgo only: kept if missing
 }
 type singleOptionset struct {
 	Optionset emberOptionset `json:"optionset"`
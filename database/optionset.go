@@ -0,0 +1,27 @@
+package database
+
+import "github.com/schnoddelbotz/amtgo/amt"
+
+// GetTLSOptionset gets the ID of an optionset equal to base, but using TLS
+// with certificates verified against cacertfile. It is created if missing.
+func GetTLSOptionset(base amt.Optionset, cacertfile string) (int, error) {
+	var id int
+	err := db.Get(&id, "SELECT id FROM optionset WHERE sw_usetls=1 AND sw_skipcertchk=0 AND opt_cacertfile=? "+
+		"AND opt_passfile=? AND opt_username=? AND opt_timeout=? AND sw_scan22=? AND sw_scan3389=? ORDER BY id LIMIT 1",
+		cacertfile, base.OptPassfile, base.Username, base.OptTimeout, base.SwScan22, base.SwScan3389)
+	if err == nil {
+		return id, nil
+	}
+
+	fields := "name,description,sw_v5,sw_dash,sw_scan22,sw_scan3389,sw_usetls," +
+		"sw_skipcertchk,opt_timeout,opt_passfile,opt_cacertfile,opt_username,sw_fallback"
+	q, err := db.Exec("INSERT INTO optionset ("+fields+") VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?)",
+		base.Name+" / TLS / VerifyCert (amtgo CA)", "Created by TLS provisioning", base.SwV5, base.SwDash,
+		base.SwScan22, base.SwScan3389, 1, 0, base.OptTimeout, base.OptPassfile, cacertfile, base.Username,
+		base.SwFallback)
+	if err != nil {
+		return 0, err
+	}
+	newID, err := q.LastInsertId()
+	return int(newID), err
+}
@@ -0,0 +1,44 @@
+package database
+
+import "time"
+
+// GetPasswordRotations gets host states of an optionset's password rotation
+func GetPasswordRotations(optionsetID int) (rotations []PasswordRotation) {
+	db.Select(&rotations, "SELECT * FROM password_rotation WHERE optionset_id=? ORDER BY host_id", optionsetID)
+	return
+}
+
+// SavePasswordRotation replaces the password rotation state of a host
+func SavePasswordRotation(rotation PasswordRotation) error {
+	rotation.Updated = int(time.Now().Unix())
+	tx, err := db.Beginx()
+	if err != nil {
+		return err
+	}
+	tx.Exec("DELETE FROM password_rotation WHERE host_id=?", rotation.HostID)
+	_, err = tx.NamedExec("INSERT INTO password_rotation (host_id, optionset_id, passfile, state, message, updated) "+
+		"VALUES (:host_id, :optionset_id, :passfile, :state, :message, :updated)", rotation)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// FinishPasswordRotation switches an optionset to the new password file
+// and removes the rotation's host states
+func FinishPasswordRotation(optionsetID int, passfile string) error {
+	tx, err := db.Beginx()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec("UPDATE optionset SET opt_passfile=? WHERE id=?", passfile, optionsetID); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err = tx.Exec("DELETE FROM password_rotation WHERE optionset_id=?", optionsetID); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
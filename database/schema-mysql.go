@@ -93,13 +93,13 @@ CREATE TABLE optionset (
 -- monitoring / scheduled tasks / interactive jobs
 CREATE TABLE job (
   id                INTEGER      NOT NULL AUTO_INCREMENT PRIMARY KEY,
-  job_type          INTEGER,     -- 1=interactive, 2=scheduled, 3=monitor
+  job_type          INTEGER,     -- 1=interactive, 2=scheduled, 3=monitor, 4=timesync
   job_status        INTEGER      DEFAULT '0',
   user_id           INTEGER      NOT NULL,
 
-  amtc_cmd          CHAR(1)      NOT NULL,  -- U/D/R/C
+  amtc_cmd          CHAR(1)      NOT NULL,  -- see amt.ShortCommandMap
   amtc_delay        REAL,
-  amtc_bootdevice   CHAR(1)      DEFAULT NULL, -- tbd; no support in amtc yet
+  amtc_bootdevice   CHAR(1)      DEFAULT NULL, -- X=PXE, H=HDD, C=CD, B=BIOS setup
 
   amtc_hosts        TEXT, -- now ids of hosts...? FIXME tbd
   ou_id             INTEGER, -- req'd to determine optionset; allow override?
@@ -157,3 +157,125 @@ INSERT INTO job VALUES(1,2,0,1,'U',2.5,NULL,NULL,4,480,NULL,127,NULL,NULL,NULL,'
 INSERT INTO job VALUES(2,2,0,1,'D',1.0,NULL,NULL,4,1290,NULL,62,NULL,NULL,NULL,'Power-Down E19 Mon-Fri');
 INSERT INTO job VALUES(3,2,0,1,'D',1.0,NULL,NULL,4,960,NULL,65,NULL,NULL,NULL,'Power-Down E19 Sat+Sun');
 `
+
+// mysqlUpgrades are run each time the database is opened. Statements must
+// be idempotent -- they add tables/columns introduced after initial schema.
+var mysqlUpgrades = []string{
+	`CREATE TABLE IF NOT EXISTS inventory (
+  host_id           INTEGER      NOT NULL PRIMARY KEY,
+  updated           INTEGER,
+  manufacturer      VARCHAR(64),
+  model             VARCHAR(64),
+  serial_number     VARCHAR(64),
+  platform_guid     VARCHAR(64),
+  bios_vendor       VARCHAR(64),
+  bios_version      VARCHAR(64),
+  bios_date         VARCHAR(32),
+  cpu_count         INTEGER,
+  cpu_max_speed     INTEGER,
+  memory_mb         INTEGER,
+  memory_modules    INTEGER,
+  amt_version       VARCHAR(32),
+
+  FOREIGN KEY(host_id) REFERENCES host(id) ON DELETE CASCADE
+)`,
+	`CREATE TABLE IF NOT EXISTS inventory_item (
+  host_id           INTEGER      NOT NULL,
+  class             VARCHAR(64)  NOT NULL,
+  data              TEXT,
+
+  FOREIGN KEY(host_id) REFERENCES host(id) ON DELETE CASCADE
+)`,
+	`CREATE TABLE IF NOT EXISTS eventlog (
+  id                INTEGER      NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  host_id           INTEGER      NOT NULL,
+  event_time        INTEGER,
+  device_address    INTEGER,
+  event_sensor_type INTEGER,
+  event_type        INTEGER,
+  event_offset      INTEGER,
+  event_source_type INTEGER,
+  event_severity    INTEGER,
+  sensor_number     INTEGER,
+  entity            INTEGER,
+  entity_instance   INTEGER,
+  event_data        VARCHAR(16),
+  description       VARCHAR(128),
+  record            VARCHAR(42)  NOT NULL,
+
+  INDEX eventlog_host_time (host_id, event_time),
+  FOREIGN KEY(host_id) REFERENCES host(id) ON DELETE CASCADE
+)`,
+	`CREATE TABLE IF NOT EXISTS auditlog (
+  id                INTEGER      NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  host_id           INTEGER      NOT NULL,
+  event_time        INTEGER,
+  audit_app_id      INTEGER,
+  event_id          INTEGER,
+  audit_app         VARCHAR(64),
+  event             VARCHAR(64),
+  initiator_type    INTEGER,
+  initiator         VARCHAR(128),
+  mc_location_type  INTEGER,
+  net_address       VARCHAR(128),
+  extended_data     VARCHAR(512),
+  record            VARCHAR(1024) NOT NULL,
+
+  INDEX auditlog_host_time (host_id, event_time),
+  FOREIGN KEY(host_id) REFERENCES host(id) ON DELETE CASCADE
+)`,
+	`ALTER TABLE host ADD COLUMN mac_address VARCHAR(17) NOT NULL DEFAULT ''`,
+	`ALTER TABLE host ADD COLUMN ip_address  VARCHAR(15) NOT NULL DEFAULT ''`,
+	`CREATE TABLE IF NOT EXISTS password_rotation (
+  host_id           INTEGER      NOT NULL PRIMARY KEY,
+  optionset_id      INTEGER      NOT NULL,
+  passfile          VARCHAR(128) NOT NULL,
+  state             VARCHAR(16)  NOT NULL,
+  message           VARCHAR(255),
+  updated           INTEGER,
+
+  FOREIGN KEY(host_id) REFERENCES host(id) ON DELETE CASCADE
+)`,
+	`ALTER TABLE optionset ADD COLUMN opt_username VARCHAR(16) NOT NULL DEFAULT ''`,
+	`CREATE TABLE IF NOT EXISTS power_capabilities (
+  host_id                   INTEGER      NOT NULL PRIMARY KEY,
+  updated                   INTEGER,
+  amt_version               VARCHAR(32),
+  power_change_capabilities VARCHAR(64),
+  power_states_supported    VARCHAR(64),
+
+  FOREIGN KEY(host_id) REFERENCES host(id) ON DELETE CASCADE
+)`,
+	`ALTER TABLE optionset ADD COLUMN sw_fallback INTEGER NOT NULL DEFAULT 0`,
+	`ALTER TABLE statelog ADD COLUMN state_power INTEGER NOT NULL DEFAULT 0`,
+	`CREATE TABLE IF NOT EXISTS alert (
+  id                INTEGER      NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  host_id           INTEGER      NOT NULL,
+  alert_time        INTEGER      NOT NULL,
+  message_id        VARCHAR(32)  NOT NULL DEFAULT '',
+  message           VARCHAR(255) NOT NULL DEFAULT '',
+  severity          INTEGER      NOT NULL DEFAULT 0,
+  category          VARCHAR(32)  NOT NULL DEFAULT '',
+
+  INDEX alert_host_time (host_id, alert_time),
+  FOREIGN KEY(host_id) REFERENCES host(id) ON DELETE CASCADE
+)`,
+	`ALTER TABLE job ADD COLUMN alarm_clock INTEGER NOT NULL DEFAULT 0`,
+	`ALTER TABLE host ADD COLUMN subnet_mask   VARCHAR(15) NOT NULL DEFAULT ''`,
+	`ALTER TABLE host ADD COLUMN wol_broadcast VARCHAR(15) NOT NULL DEFAULT ''`,
+	`ALTER TABLE host ADD COLUMN wol_only      INTEGER     NOT NULL DEFAULT 0`,
+	`CREATE TABLE IF NOT EXISTS firmware (
+  host_id            INTEGER      NOT NULL PRIMARY KEY,
+  updated            INTEGER      NOT NULL DEFAULT 0,
+  amt_version        VARCHAR(32)  NOT NULL DEFAULT '',
+  amt_build          VARCHAR(16)  NOT NULL DEFAULT '',
+  amt_sku            VARCHAR(16)  NOT NULL DEFAULT '',
+  provisioning_mode  VARCHAR(8)   NOT NULL DEFAULT '',
+  provisioning_state VARCHAR(8)   NOT NULL DEFAULT '',
+  amt_hostname       VARCHAR(64)  NOT NULL DEFAULT '',
+  amt_domain         VARCHAR(192) NOT NULL DEFAULT '',
+  digest_realm       VARCHAR(64)  NOT NULL DEFAULT '',
+
+  FOREIGN KEY(host_id) REFERENCES host(id) ON DELETE CASCADE
+)`,
+}
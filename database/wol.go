@@ -0,0 +1,51 @@
+package database
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/schnoddelbotz/amtgo/amt"
+)
+
+// WakeTargetStore implements amt.WakeTargetStore using MAC address,
+// subnet and Wake-on-LAN settings of hosts.
+type WakeTargetStore struct{}
+
+// GetWakeTarget gets the Wake-on-LAN target of a host having a MAC address.
+// Magic packets go to the host's broadcast override, its subnet-directed
+// broadcast or, if the subnet is unknown, 255.255.255.255.
+func (WakeTargetStore) GetWakeTarget(hostname string) (amt.WakeTarget, bool) {
+	host, err := GetHostByName(hostname)
+	if err != nil || host.MacAddress == "" {
+		return amt.WakeTarget{}, false
+	}
+	target := amt.WakeTarget{MACAddress: host.MacAddress, Broadcast: host.WolBroadcast, Only: host.WolOnly == 1}
+	if target.Broadcast == "" {
+		target.Broadcast = amt.DirectedBroadcast(host.IPAddress, host.SubnetMask)
+	}
+	return target, true
+}
+
+// UpdateHostWakeOnLAN sets MAC address (if not empty), broadcast override
+// and WoL only flag of a host.
+func UpdateHostWakeOnLAN(hostname string, macAddress string, broadcast string, wolOnly bool) error {
+	host, err := GetHostByName(hostname)
+	if err != nil {
+		return fmt.Errorf("host %s not found", hostname)
+	}
+	if macAddress == "" {
+		macAddress = host.MacAddress
+	} else if _, err = net.ParseMAC(macAddress); err != nil {
+		return fmt.Errorf("invalid MAC address '%s'", macAddress)
+	}
+	if broadcast != "" && net.ParseIP(broadcast).To4() == nil {
+		return fmt.Errorf("invalid broadcast address '%s'", broadcast)
+	}
+	only := 0
+	if wolOnly {
+		only = 1
+	}
+	_, err = db.Exec("UPDATE host SET mac_address=?, wol_broadcast=?, wol_only=? WHERE id=?",
+		macAddress, broadcast, only, host.ID)
+	return err
+}
@@ -6,7 +6,11 @@ import (
 	"fmt"
 	"os"
 	"runtime"
+	"strings"
+	"syscall"
+	"time"
 
+	"golang.org/x/crypto/ssh/terminal"
 	"gopkg.in/urfave/cli.v2"
 
 	"github.com/schnoddelbotz/amtgo/amt"
@@ -94,6 +98,18 @@ func main() {
 				Usage:       "CLI: CA certificate file for TLS",
 				Destination: &cliOptions.OptCacertfile,
 			},
+			&cli.StringFlag{
+				Name:    "advisories",
+				Usage:   "JSON file of firmware security advisories, adding to built-in ones",
+				EnvVars: []string{"AMTGO_ADVISORIES"},
+			},
+		},
+
+		Before: func(c *cli.Context) error {
+			if file := c.String("advisories"); file != "" {
+				return amt.LoadAdvisories(file)
+			}
+			return nil
 		},
 
 		Commands: []*cli.Command{
@@ -102,8 +118,15 @@ func main() {
 				Aliases: []string{"s"},
 				Usage:   "amtc-web server",
 				Action: func(c *cli.Context) error {
+					// set before runloops start, as they use them
+					amt.PowerCapabilitiesStore = database.PowerCapabilitiesStore{}
+					amt.WakeTargetStore = database.WakeTargetStore{}
 					go scheduler.ScheduledJobsRunloop(amt.Verbose)
 					go scheduler.MonitoringRunloop(amt.Verbose)
+					go scheduler.InventoryRunloop(amt.Verbose)
+					go scheduler.EventlogRunloop(amt.Verbose)
+					go scheduler.TimesyncRunloop(amt.Verbose)
+					go scheduler.AlarmClockRunloop(amt.Verbose)
 					webserver.Run(amt.Verbose)
 					return nil
 				},
@@ -176,7 +199,7 @@ func main() {
 						Name:        "certpath",
 						Value:       ".",
 						Aliases:     []string{"c"},
-						Usage:       "path to cert.pem/key.pem for TLS",
+						Usage:       "path to cert.pem/key.pem for TLS and amtgo's CA",
 						Destination: &webserver.TLSCertDir,
 					},
 					&cli.StringFlag{
@@ -186,6 +209,20 @@ func main() {
 						Usage:       "IP:PORT to listen on",
 						Destination: &webserver.ListenAddr,
 					},
+					&cli.StringFlag{
+						Name:        "inventory-time",
+						Value:       "02:00",
+						Aliases:     []string{"I"},
+						Usage:       "daily hardware inventory time (HH:MM), empty to disable",
+						Destination: &scheduler.InventoryTime,
+					},
+					&cli.IntFlag{
+						Name:        "eventlog-interval",
+						Value:       60,
+						Aliases:     []string{"E"},
+						Usage:       "interval in minutes for reading AMT event and audit logs, 0 to disable",
+						Destination: &scheduler.EventlogInterval,
+					},
 				},
 
 				Subcommands: []*cli.Command{
@@ -198,6 +235,60 @@ func main() {
 							return nil
 						},
 					},
+					{
+						Name:      "provisionTLS",
+						Aliases:   []string{"t"},
+						Usage:     "enable TLS on an OU's hosts using certificates of amtgo's CA in certpath; run again to resume",
+						ArgsUsage: "<ou name>",
+						Action: func(c *cli.Context) error {
+							webserver.CliProvisionTLS(c.Args().Slice(), amt.Verbose)
+							return nil
+						},
+					},
+					{
+						Name:      "rotatePassword",
+						Aliases:   []string{"r"},
+						Usage:     "rotate AMT admin password of an OU's hosts; run again to resume",
+						ArgsUsage: "<ou name> <new password file>",
+						Action: func(c *cli.Context) error {
+							scheduler.CliRotatePassword(c.Args().Slice(), amt.Verbose)
+							return nil
+						},
+					},
+					{
+						Name:      "alarmClock",
+						Aliases:   []string{"a"},
+						Usage:     "run a scheduled power-up job by hosts' AMT alarm clocks (AMT 11+), not by amtgo",
+						ArgsUsage: "<job id> on|off",
+						Action: func(c *cli.Context) error {
+							scheduler.CliAlarmClockJob(c.Args().Slice(), amt.Verbose)
+							return nil
+						},
+					},
+					{
+						Name:      "wakeOnLAN",
+						Aliases:   []string{"w"},
+						Usage:     "set Wake-on-LAN settings of a host, used if AMT is unreachable on power-up",
+						ArgsUsage: "<hostname>",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:  "mac",
+								Usage: "MAC address, empty to keep the one collected from AMT",
+							},
+							&cli.StringFlag{
+								Name:  "broadcast",
+								Usage: "broadcast address, empty for the subnet-directed broadcast of the AMT IP",
+							},
+							&cli.BoolFlag{
+								Name:  "only",
+								Usage: "host lacks usable AMT: power up by Wake-on-LAN only",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							scheduler.CliWakeOnLANHost(c.Args().Slice(), c.String("mac"), c.String("broadcast"), c.Bool("only"))
+							return nil
+						},
+					},
 				},
 			},
 
@@ -211,6 +302,490 @@ func main() {
 				},
 			},
 
+			{
+				Name:    "inventory",
+				Aliases: []string{"n"},
+				Usage:   "AMT: query hardware inventory",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:    "format",
+						Value:   amt.FormatTable,
+						Aliases: []string{"f"},
+						Usage:   "output format: table or json",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					amt.CliInventory(c.Args().Slice(), c.String("format"), cliOptions)
+					return nil
+				},
+			},
+
+			{
+				Name:    "firmware",
+				Aliases: []string{"f"},
+				Usage:   "AMT: query firmware version and provisioning state",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:    "format",
+						Value:   amt.FormatTable,
+						Aliases: []string{"f"},
+						Usage:   "output format: table or json",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					amt.CliFirmware(c.Args().Slice(), c.String("format"), cliOptions)
+					return nil
+				},
+			},
+
+			{
+				Name:  "audit",
+				Usage: "AMT: security audits",
+				Subcommands: []*cli.Command{
+					{
+						Name:      "firmware",
+						Usage:     "report firmware security advisories affecting hosts",
+						ArgsUsage: "<hosts>",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:    "format",
+								Value:   amt.FormatTable,
+								Aliases: []string{"f"},
+								Usage:   "output format: table or json",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							amt.CliAuditFirmware(c.Args().Slice(), c.String("format"), cliOptions)
+							return nil
+						},
+					},
+					{
+						Name:  "advisories",
+						Usage: "list firmware security advisories checked",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:    "format",
+								Value:   amt.FormatTable,
+								Aliases: []string{"f"},
+								Usage:   "output format: table or json",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							amt.CliAdvisories(c.String("format"))
+							return nil
+						},
+					},
+				},
+			},
+
+			{
+				Name:    "eventlog",
+				Aliases: []string{"e"},
+				Usage:   "AMT: read event log",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:    "format",
+						Value:   amt.FormatTable,
+						Aliases: []string{"f"},
+						Usage:   "output format: table or json",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					amt.CliEventLog(c.Args().Slice(), c.String("format"), cliOptions)
+					return nil
+				},
+			},
+
+			{
+				Name:    "auditlog",
+				Aliases: []string{"a"},
+				Usage:   "AMT: read security audit log",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:    "format",
+						Value:   amt.FormatTable,
+						Aliases: []string{"f"},
+						Usage:   "output format: table, json or csv",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					amt.CliAuditLog(c.Args().Slice(), c.String("format"), cliOptions)
+					return nil
+				},
+			},
+
+			{
+				Name:      "sol",
+				Usage:     "AMT: serial-over-LAN console",
+				ArgsUsage: "<host>",
+				Action: func(c *cli.Context) error {
+					amt.CliSol(c.Args().Slice(), cliOptions)
+					return nil
+				},
+			},
+
+			{
+				Name:  "kvm",
+				Usage: "AMT: KVM remote desktop",
+				Subcommands: []*cli.Command{
+					{
+						Name:      "proxy",
+						Usage:     "serve AMT KVM of a host as plain VNC server",
+						ArgsUsage: "<host>",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:    "listen",
+								Value:   "127.0.0.1:5901",
+								Aliases: []string{"l"},
+								Usage:   "VNC server listen address",
+							},
+							&cli.StringFlag{
+								Name:  "vnc-passfile",
+								Usage: "file containing VNC password required from viewers, prompted for if not given (none if empty)",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							password, err := readPassword(c.String("vnc-passfile"), "VNC password required from viewers (empty for none)")
+							if err != nil {
+								fmt.Printf("Error: %s\n", err)
+								return nil
+							}
+							amt.CliKVMProxy(c.Args().Slice(), c.String("listen"), password, cliOptions)
+							return nil
+						},
+					},
+				},
+			},
+
+			{
+				Name:  "users",
+				Usage: "AMT: manage digest user accounts",
+				Subcommands: []*cli.Command{
+					{
+						Name:      "list",
+						Usage:     "list AMT user accounts, excluding admin",
+						ArgsUsage: "<hosts>",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:    "format",
+								Value:   amt.FormatTable,
+								Aliases: []string{"f"},
+								Usage:   "output format: table or json",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							amt.CliUsers(c.Args().Slice(), c.String("format"), cliOptions)
+							return nil
+						},
+					},
+					{
+						Name:      "add",
+						Usage:     "add AMT user account",
+						ArgsUsage: "<hosts>",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:    "name",
+								Aliases: []string{"N"},
+								Usage:   "AMT username",
+							},
+							&cli.StringFlag{
+								Name:  "user-passfile",
+								Usage: "file containing password of new AMT user, prompted for if not given",
+							},
+							&cli.StringFlag{
+								Name:  "access",
+								Value: "network",
+								Usage: "access permission: local, network or any",
+							},
+							&cli.StringFlag{
+								Name:  "realms",
+								Value: "remote-control,general-info",
+								Usage: "comma-separated realms, e.g. remote-control,redirection,general-info",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							password, err := readPassword(c.String("user-passfile"), "Password of new AMT user")
+							if err != nil {
+								fmt.Printf("Error: %s\n", err)
+								return nil
+							}
+							user := amt.UserAccount{Username: c.String("name"), Password: password,
+								Access: c.String("access"), Realms: strings.Split(c.String("realms"), ",")}
+							amt.CliUserCommand(amt.CmdUserAdd, user, c.Args().Slice(), cliOptions)
+							return nil
+						},
+					},
+					{
+						Name:      "remove",
+						Usage:     "remove AMT user account",
+						ArgsUsage: "<hosts>",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:    "name",
+								Aliases: []string{"N"},
+								Usage:   "AMT username",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							user := amt.UserAccount{Username: c.String("name")}
+							amt.CliUserCommand(amt.CmdUserRemove, user, c.Args().Slice(), cliOptions)
+							return nil
+						},
+					},
+					{
+						Name:      "set-realms",
+						Usage:     "set access permission and realms of AMT user account",
+						ArgsUsage: "<hosts>",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:    "name",
+								Aliases: []string{"N"},
+								Usage:   "AMT username",
+							},
+							&cli.StringFlag{
+								Name:  "access",
+								Value: "network",
+								Usage: "access permission: local, network or any",
+							},
+							&cli.StringFlag{
+								Name:  "realms",
+								Value: "remote-control,general-info",
+								Usage: "comma-separated realms, e.g. remote-control,redirection,general-info",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							user := amt.UserAccount{Username: c.String("name"),
+								Access: c.String("access"), Realms: strings.Split(c.String("realms"), ",")}
+							amt.CliUserCommand(amt.CmdUserRealms, user, c.Args().Slice(), cliOptions)
+							return nil
+						},
+					},
+				},
+			},
+
+			{
+				Name:  "alarms",
+				Usage: "AMT: manage alarm clock wake-ups (AMT 11+)",
+				Subcommands: []*cli.Command{
+					{
+						Name:      "list",
+						Usage:     "list alarm clock occurrences",
+						ArgsUsage: "<hosts>",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:    "format",
+								Value:   amt.FormatTable,
+								Aliases: []string{"f"},
+								Usage:   "output format: table or json",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							amt.CliAlarms(c.Args().Slice(), c.String("format"), cliOptions)
+							return nil
+						},
+					},
+					{
+						Name:      "add",
+						Usage:     "add alarm clock occurrence, powering on hosts at start time",
+						ArgsUsage: "<hosts>",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:    "name",
+								Aliases: []string{"N"},
+								Usage:   "alarm name",
+							},
+							&cli.StringFlag{
+								Name:  "start",
+								Usage: "local start time: HH:MM (next occurrence) or YYYY-MM-DD HH:MM",
+							},
+							&cli.StringFlag{
+								Name:  "interval",
+								Value: "0",
+								Usage: "repeat interval, e.g. 24h; 0 to power on once",
+							},
+							&cli.BoolFlag{
+								Name:  "delete-on-completion",
+								Usage: "delete alarm once it occurred (for alarms without interval)",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							start, err := amt.ParseAlarmStart(c.String("start"))
+							if err != nil {
+								fmt.Printf("Error: %s\n", err)
+								return nil
+							}
+							interval, err := time.ParseDuration(c.String("interval"))
+							if err != nil {
+								fmt.Printf("Error: Invalid interval: %s\n", err)
+								return nil
+							}
+							alarm := amt.Alarm{Name: c.String("name"), Start: start, Interval: interval,
+								DeleteOnCompletion: c.Bool("delete-on-completion")}
+							amt.CliAlarmCommand(amt.CmdAlarmAdd, alarm, c.Args().Slice(), cliOptions)
+							return nil
+						},
+					},
+					{
+						Name:      "delete",
+						Usage:     "delete alarm clock occurrence",
+						ArgsUsage: "<hosts>",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:    "name",
+								Aliases: []string{"N"},
+								Usage:   "alarm name",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							amt.CliAlarmCommand(amt.CmdAlarmDelete, amt.Alarm{Name: c.String("name")}, c.Args().Slice(), cliOptions)
+							return nil
+						},
+					},
+				},
+			},
+
+			{
+				Name:  "events",
+				Usage: "AMT: push alerts to amtgo server's event receiver",
+				Subcommands: []*cli.Command{
+					{
+						Name:      "list",
+						Usage:     "list alert destinations of hosts",
+						ArgsUsage: "<hosts>",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:    "format",
+								Value:   amt.FormatTable,
+								Aliases: []string{"f"},
+								Usage:   "output format: table or json",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							amt.CliSubscriptions(c.Args().Slice(), c.String("format"), cliOptions)
+							return nil
+						},
+					},
+					{
+						Name:      "subscribe",
+						Usage:     "push all alerts of hosts to event receiver",
+						ArgsUsage: "<hosts>",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:  "url",
+								Usage: "event receiver URL, e.g. http://amtgo.example.com:8080/amt-events",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							amt.CliSubscribe(c.String("url"), false, c.Args().Slice(), cliOptions)
+							return nil
+						},
+					},
+					{
+						Name:      "unsubscribe",
+						Usage:     "stop pushing alerts of hosts to event receiver",
+						ArgsUsage: "<hosts>",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:  "url",
+								Usage: "event receiver URL as used to subscribe",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							amt.CliSubscribe(c.String("url"), true, c.Args().Slice(), cliOptions)
+							return nil
+						},
+					},
+				},
+			},
+
+			{
+				Name:      "wol",
+				Usage:     "send Wake-on-LAN magic packets",
+				ArgsUsage: "<MAC addresses>",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:    "broadcast",
+						Aliases: []string{"b"},
+						Value:   "255.255.255.255",
+						Usage:   "broadcast address, e.g. subnet-directed 10.1.19.255",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					amt.CliWakeOnLAN(c.Args().Slice(), c.String("broadcast"))
+					return nil
+				},
+			},
+
+			{
+				Name:      "timesync",
+				Usage:     "AMT: synchronize AMT clock to local time, reporting drift",
+				ArgsUsage: "<hosts>",
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  "check",
+						Usage: "report drift only, don't synchronize",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					amt.CliTimesync(c.Args().Slice(), c.Bool("check"), cliOptions)
+					return nil
+				},
+			},
+
+			{
+				Name:  "network",
+				Usage: "AMT: network settings",
+				Subcommands: []*cli.Command{
+					{
+						Name:      "show",
+						Usage:     "show network settings of AMT interfaces",
+						ArgsUsage: "<hosts>",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:    "format",
+								Value:   amt.FormatTable,
+								Aliases: []string{"f"},
+								Usage:   "output format: table or json",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							amt.CliNetworkShow(c.Args().Slice(), c.String("format"), cliOptions)
+							return nil
+						},
+					},
+					{
+						Name:      "set",
+						Usage:     "configure wired AMT interface",
+						ArgsUsage: "<hosts>",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:    "mode",
+								Value:   amt.NetworkDHCP,
+								Aliases: []string{"m"},
+								Usage:   "dhcp, shared (static IP shared with OS) or static",
+							},
+							&cli.StringFlag{Name: "ip", Usage: "static IP address"},
+							&cli.StringFlag{Name: "mask", Usage: "static subnet mask"},
+							&cli.StringFlag{Name: "gateway", Usage: "static default gateway"},
+							&cli.StringFlag{Name: "dns", Usage: "static primary DNS server"},
+							&cli.StringFlag{Name: "dns2", Usage: "static secondary DNS server"},
+						},
+						Action: func(c *cli.Context) error {
+							config := amt.NetworkConfig{
+								Mode:           c.String("mode"),
+								IPAddress:      c.String("ip"),
+								SubnetMask:     c.String("mask"),
+								DefaultGateway: c.String("gateway"),
+								PrimaryDNS:     c.String("dns"),
+								SecondaryDNS:   c.String("dns2"),
+							}
+							amt.CliNetworkSet(config, c.Args().Slice(), cliOptions)
+							return nil
+						},
+					},
+				},
+			},
+
 			{
 				Name:    "control",
 				Aliases: []string{"c"},
@@ -220,7 +795,25 @@ func main() {
 						Name:    "powerup",
 						Aliases: []string{"u"},
 						Usage:   "AMT power up given hosts",
+						Flags: []cli.Flag{
+							&cli.StringSliceFlag{
+								Name:  "wol-mac",
+								Usage: "hostname=MAC to wake by Wake-on-LAN if AMT is unreachable, may be repeated",
+							},
+							&cli.StringFlag{
+								Name:  "broadcast",
+								Usage: "broadcast address for Wake-on-LAN fallback (default 255.255.255.255)",
+							},
+						},
 						Action: func(c *cli.Context) error {
+							if macs := c.StringSlice("wol-mac"); len(macs) > 0 {
+								targets, err := amt.ParseWakeTargets(macs, c.String("broadcast"))
+								if err != nil {
+									fmt.Printf("Error: %s\n", err)
+									return nil
+								}
+								amt.WakeTargetStore = targets
+							}
 							amt.CliCommand(amt.CmdUp, c.Args().Slice(), cliOptions)
 							return nil
 						},
@@ -237,7 +830,7 @@ func main() {
 					{
 						Name:    "reset",
 						Aliases: []string{"r"},
-						Usage:   "AMT reset given hosts",
+						Usage:   "AMT reset (master bus reset) given hosts",
 						Action: func(c *cli.Context) error {
 							amt.CliCommand(amt.CmdReset, c.Args().Slice(), cliOptions)
 							return nil
@@ -247,7 +840,16 @@ func main() {
 						Name:    "reboot",
 						Aliases: []string{"b"},
 						Usage:   "AMT graceful reboot (AMT 9.0+ / Windows)",
+						Flags: []cli.Flag{
+							&cli.BoolFlag{
+								Name:  "fallback",
+								Usage: "reset hosts not supporting graceful reboot",
+							},
+						},
 						Action: func(c *cli.Context) error {
+							if c.Bool("fallback") {
+								cliOptions.SwFallback = 1
+							}
 							amt.CliCommand(amt.CmdReboot, c.Args().Slice(), cliOptions)
 							return nil
 						},
@@ -256,11 +858,129 @@ func main() {
 						Name:    "shutdown",
 						Aliases: []string{"s"},
 						Usage:   "AMT graceful shutdown (AMT 9.0+ / Windows)",
+						Flags: []cli.Flag{
+							&cli.BoolFlag{
+								Name:  "fallback",
+								Usage: "powerdown hosts not supporting graceful shutdown",
+							},
+						},
 						Action: func(c *cli.Context) error {
+							if c.Bool("fallback") {
+								cliOptions.SwFallback = 1
+							}
 							amt.CliCommand(amt.CmdShutdown, c.Args().Slice(), cliOptions)
 							return nil
 						},
 					},
+					{
+						Name:    "cycle",
+						Aliases: []string{"c"},
+						Usage:   "AMT power cycle given hosts (off and on again)",
+						Action: func(c *cli.Context) error {
+							amt.CliCommand(amt.CmdCycle, c.Args().Slice(), cliOptions)
+							return nil
+						},
+					},
+					{
+						Name:    "sleep",
+						Aliases: []string{"l"},
+						Usage:   "AMT put given hosts to sleep (S3)",
+						Action: func(c *cli.Context) error {
+							amt.CliCommand(amt.CmdSleep, c.Args().Slice(), cliOptions)
+							return nil
+						},
+					},
+					{
+						Name:    "hibernate",
+						Aliases: []string{"i"},
+						Usage:   "AMT hibernate given hosts (S4)",
+						Action: func(c *cli.Context) error {
+							amt.CliCommand(amt.CmdHibernate, c.Args().Slice(), cliOptions)
+							return nil
+						},
+					},
+					{
+						Name:    "nmi",
+						Aliases: []string{"n"},
+						Usage:   "AMT send non-maskable interrupt to given hosts, e.g. for kernel crash dumps",
+						Action: func(c *cli.Context) error {
+							amt.CliCommand(amt.CmdNMI, c.Args().Slice(), cliOptions)
+							return nil
+						},
+					},
+					{
+						Name:    "diagnostic",
+						Aliases: []string{"g"},
+						Usage:   "AMT send diagnostic interrupt to given hosts",
+						Action: func(c *cli.Context) error {
+							amt.CliCommand(amt.CmdDiagnostic, c.Args().Slice(), cliOptions)
+							return nil
+						},
+					},
+					{
+						Name:      "capabilities",
+						Aliases:   []string{"p"},
+						Usage:     "show power actions supported by given hosts",
+						ArgsUsage: "<hosts>",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:    "format",
+								Value:   amt.FormatTable,
+								Aliases: []string{"f"},
+								Usage:   "output format: table or json",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							amt.CliPowerCapabilities(c.Args().Slice(), c.String("format"), cliOptions)
+							return nil
+						},
+					},
+					{
+						Name:      "boot",
+						Aliases:   []string{"o"},
+						Usage:     "AMT one-time boot device selection",
+						ArgsUsage: "<hosts...>",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:    "device",
+								Value:   amt.BootDevicePxe,
+								Aliases: []string{"d"},
+								Usage:   "boot device: pxe, hdd, cd or bios (setup)",
+							},
+							&cli.StringFlag{
+								Name:    "then",
+								Aliases: []string{"t"},
+								Usage:   "power command after boot configuration: reset, cycle or powerup",
+							},
+							&cli.BoolFlag{
+								Name:  "bios-pause",
+								Usage: "pause in BIOS during boot",
+							},
+							&cli.BoolFlag{
+								Name:  "sol",
+								Usage: "redirect BIOS console via serial-over-LAN",
+							},
+							&cli.BoolFlag{
+								Name:  "ider",
+								Usage: "boot from IDE-redirected CD (requires --device cd)",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							then, ok := amt.BootThenMap[c.String("then")]
+							if !ok {
+								fmt.Printf("Error: Unsupported power command %s\n", c.String("then"))
+								return nil
+							}
+							amt.CliBoot(amt.BootOptions{
+								Device:    c.String("device"),
+								Then:      then,
+								BIOSPause: c.Bool("bios-pause"),
+								UseSOL:    c.Bool("sol"),
+								UseIDER:   c.Bool("ider"),
+							}, c.Args().Slice(), cliOptions)
+							return nil
+						},
+					},
 				},
 			},
 
@@ -313,6 +1033,85 @@ func main() {
 							},
 						},
 					},
+					{
+						Name:  "kvm",
+						Usage: "enable/disable AMT KVM, set VNC password",
+						Subcommands: []*cli.Command{
+							{
+								Name:  "enable",
+								Usage: "enable AMT KVM redirection",
+								Action: func(c *cli.Context) error {
+									amt.CliCommand(amt.CmdKvmEnable, c.Args().Slice(), cliOptions)
+									return nil
+								},
+							},
+							{
+								Name:  "disable",
+								Usage: "disable AMT KVM redirection",
+								Action: func(c *cli.Context) error {
+									amt.CliCommand(amt.CmdKvmDisable, c.Args().Slice(), cliOptions)
+									return nil
+								},
+							},
+							{
+								Name:  "set-rfb-password",
+								Usage: "set password of AMT KVM VNC port 5900 and enable it",
+								Flags: []cli.Flag{
+									&cli.StringFlag{
+										Name:  "rfb-passfile",
+										Usage: "file containing RFB password, prompted for if not given; 8 characters: upper/lower case letters, digits, special characters",
+									},
+								},
+								Action: func(c *cli.Context) error {
+									password, err := readPassword(c.String("rfb-passfile"), "RFB password")
+									if err != nil {
+										fmt.Printf("Error: %s\n", err)
+										return nil
+									}
+									amt.CliKvmPassword(password, c.Args().Slice(), cliOptions)
+									return nil
+								},
+							},
+						},
+					},
+					{
+						Name:  "consent",
+						Usage: "configure/request AMT user consent",
+						Subcommands: []*cli.Command{
+							{
+								Name:  "none",
+								Usage: "require no user consent",
+								Action: func(c *cli.Context) error {
+									amt.CliCommand(amt.CmdConsentNone, c.Args().Slice(), cliOptions)
+									return nil
+								},
+							},
+							{
+								Name:  "kvm",
+								Usage: "require user consent for KVM sessions",
+								Action: func(c *cli.Context) error {
+									amt.CliCommand(amt.CmdConsentKvm, c.Args().Slice(), cliOptions)
+									return nil
+								},
+							},
+							{
+								Name:  "all",
+								Usage: "require user consent for all redirection sessions",
+								Action: func(c *cli.Context) error {
+									amt.CliCommand(amt.CmdConsentAll, c.Args().Slice(), cliOptions)
+									return nil
+								},
+							},
+							{
+								Name:  "request",
+								Usage: "request user consent, prompting for the code shown on screen",
+								Action: func(c *cli.Context) error {
+									amt.CliConsent(c.Args().Slice(), cliOptions)
+									return nil
+								},
+							},
+						},
+					},
 					{
 						Name:  "sol",
 						Usage: "enable/disable AMT serial-over-LAN",
@@ -337,6 +1136,65 @@ func main() {
 					},
 				},
 			},
+
+			{
+				Name:    "wsman",
+				Aliases: []string{"w"},
+				Usage:   "AMT: generic WS-Man operations",
+				Subcommands: []*cli.Command{
+					{
+						Name:      "enumerate",
+						Aliases:   []string{"e"},
+						Usage:     "enumerate instances of a CIM/AMT/IPS class",
+						ArgsUsage: "<resourceURI|class> <hosts...>",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:    "format",
+								Value:   amt.FormatTable,
+								Aliases: []string{"f"},
+								Usage:   "output format: table or json",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							amt.CliEnumerate(c.Args().First(), c.String("format"), c.Args().Tail(), cliOptions)
+							return nil
+						},
+					},
+					{
+						Name:      "get",
+						Aliases:   []string{"g"},
+						Usage:     "get an instance of a CIM/AMT/IPS class",
+						ArgsUsage: "<resourceURI|class> <hosts...>",
+						Flags:     wsmanFlags(false, false),
+						Action: func(c *cli.Context) error {
+							amt.CliGet(wsmanArgs(c), c.Args().Tail(), cliOptions)
+							return nil
+						},
+					},
+					{
+						Name:      "put",
+						Aliases:   []string{"p"},
+						Usage:     "modify properties of an instance",
+						ArgsUsage: "<resourceURI|class> <hosts...>",
+						Flags:     wsmanFlags(true, false),
+						Action: func(c *cli.Context) error {
+							amt.CliPut(wsmanArgs(c), c.Args().Tail(), cliOptions)
+							return nil
+						},
+					},
+					{
+						Name:      "invoke",
+						Aliases:   []string{"i"},
+						Usage:     "invoke a method of a CIM/AMT/IPS class",
+						ArgsUsage: "<resourceURI|class> <hosts...>",
+						Flags:     wsmanFlags(true, true),
+						Action: func(c *cli.Context) error {
+							amt.CliInvoke(wsmanArgs(c), c.Args().Tail(), cliOptions)
+							return nil
+						},
+					},
+				},
+			},
 		},
 
 		Action: func(c *cli.Context) error {
@@ -349,6 +1207,67 @@ func main() {
 	app.Run(os.Args)
 }
 
+// wsmanFlags returns flags for wsman get/put/invoke commands
+func wsmanFlags(input bool, method bool) []cli.Flag {
+	flags := []cli.Flag{
+		&cli.StringFlag{
+			Name:    "format",
+			Value:   amt.FormatTable,
+			Aliases: []string{"f"},
+			Usage:   "output format: table or json",
+		},
+		&cli.StringSliceFlag{
+			Name:    "selector",
+			Aliases: []string{"s"},
+			Usage:   "selector as Name=Value, may be repeated",
+		},
+	}
+	if input {
+		flags = append(flags,
+			&cli.StringSliceFlag{
+				Name:    "param",
+				Aliases: []string{"P"},
+				Usage:   "property/parameter as Name=Value, may be repeated",
+			},
+			&cli.StringFlag{
+				Name:    "input",
+				Aliases: []string{"i"},
+				Usage:   "read properties/parameters from JSON or XML file",
+			})
+	}
+	if method {
+		flags = append(flags, &cli.StringFlag{
+			Name:    "method",
+			Aliases: []string{"m"},
+			Usage:   "name of method to invoke, e.g. RequestStateChange",
+		})
+	}
+	return flags
+}
+
+func wsmanArgs(c *cli.Context) amt.WsmanArgs {
+	return amt.WsmanArgs{
+		Resource:  c.Args().First(),
+		Method:    c.String("method"),
+		Selectors: c.StringSlice("selector"),
+		Params:    c.StringSlice("param"),
+		InputFile: c.String("input"),
+		Format:    c.String("format"),
+	}
+}
+
+// readPassword reads a password from passfile or prompts for it -- keeping
+// it out of process list and shell history.
+func readPassword(passfile string, prompt string) (string, error) {
+	if passfile != "" {
+		return amt.ReadPasswordFile(passfile)
+	}
+	fmt.Print(prompt + ": ")
+	password, err := terminal.ReadPassword(int(syscall.Stdin))
+	fmt.Println()
+	return string(password), err
+}
+
 // Version returns current amtgo version as string
 func Version() string {
 	if len(AppVersion) == 0 {
@@ -0,0 +1,227 @@
+package scheduler
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/schnoddelbotz/amtgo/amt"
+	"github.com/schnoddelbotz/amtgo/database"
+)
+
+// alarms are pushed daily -- covering hosts added to OUs and
+// start times shifted by daylight saving time changes
+const alarmPushInterval = 24 * time.Hour
+
+// names of alarms pushed by amtgo start with alarmNamePrefix, followed by job ID
+const alarmNamePrefix = "amtgo-job-"
+
+// alarmFallback maps IDs of alarm clock jobs pushed by PushAlarms to host
+// IDs, true if the host's alarm clock could not be set (unreachable,
+// pre-AMT 11 or WoL only hosts). The scheduler powers up these hosts as
+// usual, like hosts not pushed to (e.g. added since).
+var (
+	alarmFallback     = make(map[int]map[int]bool)
+	alarmFallbackLock sync.Mutex
+)
+
+// AlarmClockRunloop pushes alarm clock jobs to hosts' AMT alarm clocks.
+func AlarmClockRunloop(verbose bool) {
+	for {
+		time.Sleep(30 * time.Second) // sleep first -- db may not be open yet...
+		PushAlarms(verbose)
+		time.Sleep(alarmPushInterval)
+	}
+}
+
+// CliAlarmClockJob enables (on) or disables (off) running a scheduled
+// power-up job by hosts' AMT alarm clocks, and pushes alarms.
+func CliAlarmClockJob(args []string, verbose bool) {
+	if len(args) != 2 || (args[1] != "on" && args[1] != "off") {
+		fmt.Println("Error: Expected job ID and on or off as arguments")
+		return
+	}
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		fmt.Printf("Error: Invalid job ID %s\n", args[0])
+		return
+	}
+	// this happens from terminal, so DB is not open yet...
+	database.OpenDB()
+	defer database.CloseDB()
+	job := database.GetJob(id)
+	if job.ID != id || job.JobType != 2 || job.AmtcCmd == nil || amt.ShortCommandMap[*job.AmtcCmd] != amt.CmdUp {
+		fmt.Printf("Error: Job %d is no scheduled power-up job\n", id)
+		return
+	}
+	job.AlarmClock = 0
+	if args[1] == "on" {
+		job.AlarmClock = 1
+	}
+	if err = database.UpdateJobAlarmClock(id, job.AlarmClock); err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+	PushAlarms(verbose)
+}
+
+// PushAlarms adds the alarms of alarm clock jobs to the AMT alarm clocks
+// of all enabled hosts in the jobs' OUs, and deletes alarms added by amtgo
+// that no longer match any job.
+func PushAlarms(verbose bool) {
+	jobsByOu := make(map[int][]database.Job)
+	for _, job := range database.GetAlarmClockJobs() {
+		if job.OuID != nil {
+			jobsByOu[*job.OuID] = append(jobsByOu[*job.OuID], job)
+		}
+	}
+	now := time.Now()
+	var (
+		lock    sync.Mutex
+		updated int
+		failed  int
+	)
+	fallback := make(map[int]map[int]bool)
+	for _, jobs := range jobsByOu {
+		for _, job := range jobs {
+			fallback[job.ID] = make(map[int]bool)
+		}
+	}
+	forEachHost(20, func(host database.Host, optionset amt.Optionset) {
+		var alarms []amt.Alarm
+		for _, job := range jobsByOu[host.OuID] {
+			alarms = append(alarms, jobAlarms(job, now)...)
+		}
+		var changed int
+		var err error
+		if host.WolOnly != 1 { // else no usable AMT: woken by the scheduler
+			changed, err = syncAlarms(amt.NewClient(host.Hostname, optionset), alarms)
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		if host.WolOnly == 1 || (err != nil && len(alarms) > 0) {
+			for _, job := range jobsByOu[host.OuID] {
+				fallback[job.ID][host.ID] = true
+			}
+			if err != nil {
+				failed++
+				log.Printf("Alarm clock of %s: %s -- powered up by scheduler instead", host.Hostname, err)
+			}
+			return
+		}
+		for _, job := range jobsByOu[host.OuID] {
+			fallback[job.ID][host.ID] = false
+		}
+		if changed > 0 {
+			updated++
+			if verbose {
+				log.Printf("Alarm clock of %s: %d alarms changed", host.Hostname, changed)
+			}
+		}
+	})
+	alarmFallbackLock.Lock()
+	alarmFallback = fallback
+	alarmFallbackLock.Unlock()
+	if updated > 0 || failed > 0 {
+		message := fmt.Sprintf("Alarm clock: %d hosts updated, %d failed (powered up by scheduler)", updated, failed)
+		database.InsertNotification(database.NotificationTypeComment, message)
+	}
+}
+
+// alarmFallbackHosts returns the hosts an alarm clock job has to power up
+// by the scheduler: hosts whose alarm clock was not set, or all hosts if
+// the job's alarms were not pushed yet (e.g. enabled from command line).
+func alarmFallbackHosts(job database.Job, hosts []database.Host) []database.Host {
+	alarmFallbackLock.Lock()
+	defer alarmFallbackLock.Unlock()
+	var fallbackHosts []database.Host
+	for _, host := range hosts {
+		if failed, pushed := alarmFallback[job.ID][host.ID]; failed || !pushed {
+			fallbackHosts = append(fallbackHosts, host)
+		}
+	}
+	return fallbackHosts
+}
+
+// syncAlarms makes alarms the only amtgo alarms of a host's alarm clock.
+// It returns the number of alarms added or deleted.
+func syncAlarms(client *amt.Client, alarms []amt.Alarm) (changed int, err error) {
+	existing, err := client.Alarms()
+	if err != nil {
+		return
+	}
+	present := make(map[string]bool)
+	for _, alarm := range existing {
+		if !strings.HasPrefix(alarm.Name, alarmNamePrefix) {
+			continue
+		}
+		if containsAlarm(alarms, alarm) {
+			present[alarm.Name] = true
+			continue
+		}
+		if err = client.DeleteAlarm(alarm.Name); err != nil {
+			return
+		}
+		changed++
+	}
+	for _, alarm := range alarms {
+		if present[alarm.Name] {
+			continue
+		}
+		if err = client.AddAlarm(alarm); err != nil {
+			return
+		}
+		changed++
+	}
+	return
+}
+
+// containsAlarm returns true if alarms contains an alarm named like alarm,
+// occurring at the same times. Start times of repeated alarms may differ
+// by multiples of their interval, as passed occurrences don't matter.
+func containsAlarm(alarms []amt.Alarm, alarm amt.Alarm) bool {
+	for _, a := range alarms {
+		if a.Name != alarm.Name || a.Interval != alarm.Interval {
+			continue
+		}
+		offset := a.Start.Sub(alarm.Start)
+		if offset == 0 || (a.Interval > 0 && offset%a.Interval == 0) {
+			return true
+		}
+	}
+	return false
+}
+
+// jobAlarms returns the alarms of a power-up job: a daily alarm for jobs
+// repeated on all days, a weekly alarm per day otherwise. As AMT only
+// powers on, boot devices of jobs are ignored.
+func jobAlarms(job database.Job, now time.Time) (alarms []amt.Alarm) {
+	if job.AmtcCmd == nil || amt.ShortCommandMap[*job.AmtcCmd] != amt.CmdUp || job.RepeatDays == nil {
+		return
+	}
+	name := alarmNamePrefix + strconv.Itoa(job.ID)
+	days := *job.RepeatDays & 127 // bit 0: sunday
+	if days == 127 {
+		return []amt.Alarm{{Name: name, Start: nextStart(now, -1, job.StartTime), Interval: 24 * time.Hour}}
+	}
+	for day := 0; day < 7; day++ {
+		if days&(1<<uint(day)) != 0 {
+			alarms = append(alarms, amt.Alarm{Name: name + "-" + strconv.Itoa(day),
+				Start: nextStart(now, day, job.StartTime), Interval: 7 * 24 * time.Hour})
+		}
+	}
+	return
+}
+
+// nextStart returns the next time after now at minuteOfDay on weekday
+// (time.Weekday value), or on any day if weekday is -1.
+func nextStart(now time.Time, weekday int, minuteOfDay int) time.Time {
+	start := time.Date(now.Year(), now.Month(), now.Day(), minuteOfDay/60, minuteOfDay%60, 0, 0, now.Location())
+	for !start.After(now) || (weekday >= 0 && int(start.Weekday()) != weekday) {
+		start = start.AddDate(0, 0, 1)
+	}
+	return start
+}
@@ -0,0 +1,28 @@
+package scheduler
+
+import (
+	"log"
+
+	"github.com/schnoddelbotz/amtgo/amt"
+	"github.com/schnoddelbotz/amtgo/database"
+)
+
+// CollectAuditlogs reads AMT audit logs of all enabled hosts and stores new records.
+func CollectAuditlogs(verbose bool) {
+	forEachHost(20, func(host database.Host, optionset amt.Optionset) {
+		auditlog, err := amt.NewClient(host.Hostname, optionset).AuditLog()
+		if err != nil {
+			if verbose {
+				log.Printf("Reading audit log of %s failed: %s", host.Hostname, err)
+			}
+			return
+		}
+		added, err := database.InsertAuditRecords(host.ID, auditlog.Records)
+		if err != nil {
+			log.Printf("Error saving audit records of %s: %s", host.Hostname, err)
+		}
+		if verbose && added > 0 {
+			log.Printf("Stored %d new audit records of %s", added, host.Hostname)
+		}
+	})
+}
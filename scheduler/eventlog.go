@@ -0,0 +1,46 @@
+package scheduler
+
+import (
+	"log"
+	"time"
+
+	"github.com/schnoddelbotz/amtgo/amt"
+	"github.com/schnoddelbotz/amtgo/database"
+)
+
+// EventlogInterval is the interval in minutes for reading AMT event and audit logs.
+// Log collection is disabled if 0.
+var EventlogInterval int
+
+// EventlogRunloop periodically stores new AMT event and audit log records of all hosts.
+func EventlogRunloop(verbose bool) {
+	if EventlogInterval <= 0 {
+		return
+	}
+	for {
+		time.Sleep(30 * time.Second) // sleep first -- db may not be open yet...
+		CollectEventlogs(verbose)
+		CollectAuditlogs(verbose)
+		time.Sleep(time.Duration(EventlogInterval)*time.Minute - 30*time.Second)
+	}
+}
+
+// CollectEventlogs reads AMT event logs of all enabled hosts and stores new events.
+func CollectEventlogs(verbose bool) {
+	forEachHost(20, func(host database.Host, optionset amt.Optionset) {
+		events, err := amt.NewClient(host.Hostname, optionset).EventLog()
+		if err != nil {
+			if verbose {
+				log.Printf("Reading event log of %s failed: %s", host.Hostname, err)
+			}
+			return
+		}
+		added, err := database.InsertEvents(host.ID, events)
+		if err != nil {
+			log.Printf("Error saving events of %s: %s", host.Hostname, err)
+		}
+		if verbose && added > 0 {
+			log.Printf("Stored %d new events of %s", added, host.Hostname)
+		}
+	})
+}
@@ -0,0 +1,136 @@
+package scheduler
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/schnoddelbotz/amtgo/amt"
+	"github.com/schnoddelbotz/amtgo/database"
+)
+
+// InventoryTime is the time of day (HH:MM) to refresh hardware inventory.
+// Inventory collection is disabled if empty.
+var InventoryTime string
+
+// InventoryRunloop refreshes the hardware inventory once a day.
+func InventoryRunloop(verbose bool) {
+	if InventoryTime == "" {
+		return
+	}
+	at, err := time.Parse("15:04", InventoryTime)
+	if err != nil {
+		log.Printf("Invalid inventory time %s, expected HH:MM -- inventory disabled", InventoryTime)
+		return
+	}
+	startMinute := at.Hour()*60 + at.Minute()
+	lastRunDay := -1
+
+	for {
+		time.Sleep(30 * time.Second) // sleep first -- db may not be open yet...
+		now := time.Now()
+		if now.Hour()*60+now.Minute() == startMinute && now.YearDay() != lastRunDay {
+			lastRunDay = now.YearDay()
+			CollectInventory(verbose)
+		}
+	}
+}
+
+// CollectInventory collects and stores the inventory of all enabled hosts.
+func CollectInventory(verbose bool) {
+	if verbose {
+		log.Println("Inventory collection started")
+	}
+	forEachHost(20, func(host database.Host, optionset amt.Optionset) {
+		inventory, err := amt.NewClient(host.Hostname, optionset).Inventory()
+		if err != nil {
+			if verbose {
+				log.Printf("Inventory of %s failed: %s", host.Hostname, err)
+			}
+			return
+		}
+		inventory.HostID = host.ID
+		if err = database.SaveInventory(inventory); err != nil {
+			log.Printf("Error saving inventory of %s: %s", host.Hostname, err)
+		}
+		collectNetwork(host, optionset, verbose)
+		collectPowerCapabilities(host, optionset, verbose)
+		collectFirmware(host, optionset, verbose)
+	})
+	auditFirmware()
+	if verbose {
+		log.Println("Inventory collection done")
+	}
+}
+
+// collectNetwork stores MAC, IP and subnet of a host's wired AMT interface,
+// logging IP changes -- AMT may have drifted from the expected address.
+func collectNetwork(host database.Host, optionset amt.Optionset, verbose bool) {
+	settings, err := amt.NewClient(host.Hostname, optionset).NetworkSettings()
+	if err != nil || len(settings) == 0 {
+		if verbose {
+			log.Printf("Network settings of %s unavailable: %v", host.Hostname, err)
+		}
+		return
+	}
+	wired := settings[0]
+	if host.IPAddress != "" && host.IPAddress != wired.IPAddress {
+		log.Printf("AMT IP address of %s changed from %s to %s", host.Hostname, host.IPAddress, wired.IPAddress)
+	}
+	if err = database.UpdateHostNetwork(host.ID, wired.MACAddress, wired.IPAddress, wired.SubnetMask); err != nil {
+		log.Printf("Error saving network settings of %s: %s", host.Hostname, err)
+	}
+}
+
+// collectPowerCapabilities refreshes the power capabilities cached for a host.
+func collectPowerCapabilities(host database.Host, optionset amt.Optionset, verbose bool) {
+	capabilities, err := amt.NewClient(host.Hostname, optionset).PowerCapabilities()
+	if err != nil {
+		if verbose {
+			log.Printf("Power capabilities of %s unavailable: %s", host.Hostname, err)
+		}
+		return
+	}
+	if err = (database.PowerCapabilitiesStore{}).SavePowerCapabilities(host.Hostname, capabilities); err != nil {
+		log.Printf("Error saving power capabilities of %s: %s", host.Hostname, err)
+	}
+}
+
+// collectFirmware stores AMT firmware version and provisioning of a host.
+func collectFirmware(host database.Host, optionset amt.Optionset, verbose bool) {
+	firmware, err := amt.NewClient(host.Hostname, optionset).Firmware()
+	if err != nil {
+		if verbose {
+			log.Printf("Firmware of %s unavailable: %s", host.Hostname, err)
+		}
+		return
+	}
+	firmware.HostID = host.ID
+	if err = database.SaveFirmware(firmware); err != nil {
+		log.Printf("Error saving firmware of %s: %s", host.Hostname, err)
+	}
+}
+
+// notifiedAdvisories keeps the affected hosts last notified per advisory
+var notifiedAdvisories = make(map[string]string)
+
+// auditFirmware adds a warning notification per advisory whose hosts
+// affected (by stored AMT firmware) changed since the last notification.
+func auditFirmware() {
+	affected := make(map[string][]string)
+	for _, audit := range database.GetFirmwareAudits() {
+		for _, id := range audit.Advisories {
+			affected[id] = append(affected[id], audit.Hostname)
+		}
+	}
+	for _, advisory := range amt.Advisories {
+		hosts := strings.Join(affected[advisory.ID], ",") // ordered by hostname
+		if hosts == notifiedAdvisories[advisory.ID] {
+			continue
+		}
+		notifiedAdvisories[advisory.ID] = hosts
+		database.InsertNotification(database.NotificationTypeWarning,
+			fmt.Sprintf("Firmware: %d hosts affected by %s (%s)", len(affected[advisory.ID]), advisory.ID, advisory.Severity))
+	}
+}
@@ -0,0 +1,106 @@
+package scheduler
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/schnoddelbotz/amtgo/amt"
+	"github.com/schnoddelbotz/amtgo/database"
+)
+
+// number of attempts to verify TLS while AMT applies new settings
+const tlsVerifyAttempts = 6
+
+// CertificateIssuer issues TLS certificates for AMT hosts.
+type CertificateIssuer interface {
+	// Issue returns a certificate (DER) for hostname, using the public key of csr.
+	Issue(hostname string, csr *x509.CertificateRequest) ([]byte, error)
+	// Certificate returns the issuer's CA certificate (DER).
+	Certificate() []byte
+	// CertificateFile returns the path of the CA certificate (PEM), as used by optionsets.
+	CertificateFile() string
+}
+
+// ProvisionTLS enables TLS on all enabled hosts of an OU, using certificates
+// issued by ca. Hosts already verifying against ca are skipped, so a partially
+// failed provisioning is resumed by running it again. Once all hosts succeed,
+// the OU is switched to an optionset using TLS, verified against ca.
+func ProvisionTLS(ouName string, ca CertificateIssuer, verbose bool) error {
+	ou, err := database.GetOuByName(ouName)
+	if err != nil || ou.OptionsetID == nil {
+		return fmt.Errorf("no OU named '%s' having an optionset", ouName)
+	}
+	optionset := database.GetOptionset(*ou.OptionsetID)
+	if optionset.SwUseTLS == 1 && optionset.SwSkipcertchk == 0 && optionset.OptCacertfile == ca.CertificateFile() {
+		return fmt.Errorf("OU '%s' already uses TLS verified against %s", ouName, ca.CertificateFile())
+	}
+
+	oldOptions := prepareOptionset(optionset)
+	tlsOptions := oldOptions
+	tlsOptions.SwUseTLS = 1
+	tlsOptions.SwSkipcertchk = 0
+	tlsOptions.OptCacertfile = ca.CertificateFile()
+	tlsOptions.CaCertData = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Certificate()})
+
+	var lock sync.Mutex
+	failed := 0
+	forEachOuHost(20, func(o database.Ou) bool { return o.ID == ou.ID }, func(host database.Host, _ amt.Optionset) {
+		err := provisionHost(host.Hostname, ca, oldOptions, tlsOptions)
+		lock.Lock()
+		defer lock.Unlock()
+		if err != nil {
+			failed++
+			fmt.Printf("%s: failed: %s\n", host.Hostname, err)
+		} else if verbose {
+			fmt.Printf("%s: TLS enabled\n", host.Hostname)
+		}
+	})
+	if failed > 0 {
+		return fmt.Errorf("%d hosts of OU '%s' failed -- optionset unchanged, run again to resume", failed, ouName)
+	}
+
+	optionsetID, err := database.GetTLSOptionset(optionset, ca.CertificateFile())
+	if err != nil {
+		return err
+	}
+	if err = database.UpdateOuOptionset(ou.ID, optionsetID); err != nil {
+		return err
+	}
+	database.InsertNotification(database.NotificationTypeUser, fmt.Sprintf("TLS provisioned for %s", ou.Name))
+	fmt.Printf("All hosts provisioned, OU '%s' now uses optionset %d\n", ouName, optionsetID)
+	return nil
+}
+
+// provisionHost enables TLS on a host, unless it verifies against the CA already.
+func provisionHost(hostname string, ca CertificateIssuer, oldOptions amt.Optionset, tlsOptions amt.Optionset) error {
+	if _, err := amt.NewClient(hostname, tlsOptions).PowerState(); err == nil {
+		return nil
+	}
+	client := amt.NewClient(hostname, oldOptions)
+	csr, err := client.CertificateRequest(hostname)
+	if err != nil {
+		return err
+	}
+	certificate, err := ca.Issue(hostname, csr)
+	if err != nil {
+		return err
+	}
+	if err = client.EnableTLS(certificate, ca.Certificate()); err != nil {
+		return err
+	}
+	// AMT restarts its web server to apply TLS settings
+	for attempt := 1; ; attempt++ {
+		time.Sleep(5 * time.Second)
+		_, err = amt.NewClient(hostname, tlsOptions).PowerState()
+		if err == nil || attempt == tlsVerifyAttempts {
+			break
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("TLS verification failed: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/schnoddelbotz/amtgo/amt"
+	"github.com/schnoddelbotz/amtgo/database"
+)
+
+// RotatePassword sets the AMT admin password read from passfile on all
+// enabled hosts of an OU, verifying each host using the new password.
+// Host states are kept in the database, so an interrupted or partially
+// failed rotation is resumed by running it again with the same passfile.
+// Once all enabled hosts of all OUs using the OU's optionset are rotated,
+// the optionset is switched to passfile.
+func RotatePassword(ouName string, passfile string, verbose bool) error {
+	ou, err := database.GetOuByName(ouName)
+	if err != nil || ou.OptionsetID == nil {
+		return fmt.Errorf("no OU named '%s' having an optionset", ouName)
+	}
+	optionset := database.GetOptionset(*ou.OptionsetID)
+	if optionset.OptPassfile == passfile {
+		return fmt.Errorf("optionset '%s' already uses password file %s", optionset.Name, passfile)
+	}
+	password, err := amt.ReadPasswordFile(passfile)
+	if err != nil {
+		return err
+	}
+	if err := amt.CheckAdminPassword(password); err != nil {
+		return err
+	}
+
+	states := make(map[int]database.PasswordRotation)
+	for _, rotation := range database.GetPasswordRotations(optionset.ID) {
+		if rotation.Passfile != passfile {
+			return fmt.Errorf("unfinished rotation of optionset '%s' to password file %s -- resume it first",
+				optionset.Name, rotation.Passfile)
+		}
+		states[rotation.HostID] = rotation
+	}
+
+	oldOptions := prepareOptionset(optionset)
+	if oldOptions.Username != "admin" {
+		return fmt.Errorf("optionset '%s' uses AMT user %s, only admin passwords can be rotated",
+			optionset.Name, oldOptions.Username)
+	}
+	newOptions := oldOptions
+	newOptions.Password = password
+
+	// hosts not attempted yet, e.g. by an interrupted run, are pending
+	hosts := optionsetHosts(optionset.ID)
+	for _, host := range hosts {
+		if _, ok := states[host.ID]; ok {
+			continue
+		}
+		rotation := database.PasswordRotation{HostID: host.ID, OptionsetID: optionset.ID, Passfile: passfile,
+			State: database.RotationPending}
+		if err := database.SavePasswordRotation(rotation); err != nil {
+			return err
+		}
+		states[host.ID] = rotation
+	}
+
+	var lock sync.Mutex
+	forEachOuHost(20, func(o database.Ou) bool { return o.ID == ou.ID }, func(host database.Host, _ amt.Optionset) {
+		lock.Lock()
+		rotation, ok := states[host.ID]
+		lock.Unlock()
+		if ok && rotation.State == database.RotationRotated {
+			return
+		}
+		rotation = database.PasswordRotation{HostID: host.ID, OptionsetID: optionset.ID, Passfile: passfile}
+		rotation.State, rotation.Message = rotateHost(host.Hostname, oldOptions, newOptions)
+		if err := database.SavePasswordRotation(rotation); err != nil {
+			rotation.Message = fmt.Sprintf("saving state failed: %s", err)
+		}
+		if verbose || rotation.State != database.RotationRotated {
+			fmt.Printf("%s: %s %s\n", host.Hostname, rotation.State, rotation.Message)
+		}
+		lock.Lock()
+		states[host.ID] = rotation
+		lock.Unlock()
+	})
+
+	// all enabled hosts using the optionset must be rotated before switching it
+	remaining := 0
+	for _, host := range hosts {
+		if states[host.ID].State != database.RotationRotated {
+			remaining++
+		}
+	}
+	if remaining > 0 {
+		return fmt.Errorf("%d hosts using optionset '%s' not rotated yet -- optionset unchanged, run again to resume",
+			remaining, optionset.Name)
+	}
+	if err := database.FinishPasswordRotation(optionset.ID, passfile); err != nil {
+		return err
+	}
+	database.InsertNotification(database.NotificationTypeUser,
+		fmt.Sprintf("AMT password rotated for optionset %s", optionset.Name))
+	fmt.Printf("All hosts rotated, optionset '%s' now uses password file %s\n", optionset.Name, passfile)
+	return nil
+}
+
+// optionsetHosts returns the enabled hosts of all OUs using an optionset.
+func optionsetHosts(optionsetID int) (hosts []database.Host) {
+	for _, ou := range database.GetOus() {
+		if ou.OptionsetID == nil || *ou.OptionsetID != optionsetID {
+			continue
+		}
+		for _, host := range database.GetHostsByOu(ou.ID) {
+			if host.Enabled == 1 {
+				hosts = append(hosts, host)
+			}
+		}
+	}
+	return
+}
+
+// rotateHost sets the new password on a host, unless a previous run did
+// already, and verifies it. It returns the resulting state and a message.
+func rotateHost(hostname string, oldOptions amt.Optionset, newOptions amt.Optionset) (string, string) {
+	_, err := amt.NewClient(hostname, newOptions).PowerState()
+	if err == nil {
+		return database.RotationRotated, ""
+	}
+	var unauthorized *amt.ErrUnauthorized
+	if !errors.As(err, &unauthorized) {
+		// unreachable, or TLS issues -- trying the old password won't help
+		return database.RotationFailed, err.Error()
+	}
+	if err := amt.NewClient(hostname, oldOptions).SetAdminPassword(newOptions.Password); err != nil {
+		return database.RotationFailed, err.Error()
+	}
+	if _, err := amt.NewClient(hostname, newOptions).PowerState(); err != nil {
+		return database.RotationFailed, fmt.Sprintf("verification failed: %s", err)
+	}
+	return database.RotationRotated, ""
+}
+
+// CliRotatePassword runs RotatePassword from command line.
+func CliRotatePassword(args []string, verbose bool) {
+	if len(args) != 2 {
+		fmt.Println("Error: Expected OU name and new password file as arguments")
+		return
+	}
+	// this happens from terminal, so DB is not open yet...
+	database.OpenDB()
+	defer database.CloseDB()
+	if err := RotatePassword(args[0], args[1], verbose); err != nil {
+		fmt.Printf("Error: %s\n", err)
+	}
+}
@@ -3,11 +3,13 @@
 package scheduler
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"log"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -22,6 +24,7 @@ type emberJob struct {
 	JobType        int      `json:"job_type"`
 	AmtcCmd        string   `json:"amtc_cmd"`
 	AmtcDelay      float64  `json:"amtc_delay"`
+	AmtcBootdevice *string  `json:"amtc_bootdevice"`
 	AmtcHosts      []string `json:"hosts"`
 	OuID           string   `json:"ou_id"`
 	RepeatInterval *int     `json:"repeat_interval"`
@@ -30,6 +33,7 @@ type emberJob struct {
 	LastDone       *int     `json:"last_done"`
 	StartTime      int      `json:"start_time"`
 	Description    *string  `json:"description"`
+	AlarmClock     *bool    `json:"alarm_clock"` // amtgo only: not sent by GUI, kept if missing
 }
 type newJob struct {
 	SingleJob emberJob `json:"job"`
@@ -38,9 +42,16 @@ type newJob struct {
 // map HostID -> state
 var lastStateMap = map[int]amt.Laststate{}
 
-// mutex for updating lastStateMap
+// map HostID -> error kind last notified by monitoring, see warnMonitoringError
+var monitoringWarnings = map[int]string{}
+
+// mutex for updating lastStateMap and monitoringWarnings
 var mutex = &sync.Mutex{}
 
+// monitoring passes are cancelled after monitoringPassTimeout; hosts not
+// scanned by then keep their state until the next pass
+const monitoringPassTimeout = 2 * time.Minute
+
 // ScheduledJobsRunloop periodically checks DB for scheduled tasks.
 func ScheduledJobsRunloop(verbose bool) {
 	lastRunMinute := -1
@@ -57,57 +68,130 @@ func ScheduledJobsRunloop(verbose bool) {
 			jobs := database.GetScheduledJobs(int(nowWeekday), nowMinuteOfDay)
 			for _, job := range jobs {
 				ou := database.GetOu(*job.OuID)
-				optionset := database.GetOptionset(*ou.OptionsetID)
-				optionset.Username = "admin" // FIXME
-				optionset.Password = getPasswordFromFile(optionset.OptPassfile)
-				if optionset.SwUseTLS == 1 && optionset.SwSkipcertchk != 1 && optionset.OptCacertfile != "" {
-					optionset.CaCertData = amt.LoadCaCertFile(optionset.OptCacertfile)
-				}
-				myhosts := database.GetHostsByOu(ou.ID)
-				var hostsStringArr []string
-				for _, h := range myhosts {
-					hostsStringArr = append(hostsStringArr, h.Hostname)
-				}
-				if verbose {
-					log.Printf("Scheduled command: %s, delay: %f, hosts: %s", *job.AmtcCmd, *job.AmtcDelay, hostsStringArr)
-				}
-				if *job.AmtcCmd == "U" {
-					database.InsertNotification(database.NotificationTypePowerOn, fmt.Sprintf("Scheduled power-up %s", ou.Name))
-				} else if *job.AmtcCmd == "D" {
-					database.InsertNotification(database.NotificationTypePowerOff, fmt.Sprintf("Scheduled power-down %s", ou.Name))
+				runScheduledJob(job, ou, database.GetHostsByOu(ou.ID), verbose)
+			}
+			// alarm clock jobs power up hosts whose alarm clock couldn't be set
+			for _, job := range database.GetScheduledAlarmClockJobs(int(nowWeekday), nowMinuteOfDay) {
+				ou := database.GetOu(*job.OuID)
+				if hosts := alarmFallbackHosts(job, database.GetHostsByOu(ou.ID)); len(hosts) > 0 {
+					runScheduledJob(job, ou, hosts, verbose)
 				}
-
-				go amt.SequentialCommand(amt.ShortCommandMap[*job.AmtcCmd], hostsStringArr, optionset, *job.AmtcDelay)
-				//    ^^^^^^^^^ logs to notification (started, OK/FAIL done)
-				//    ^^^^^^^^^ same is used for GUI submitted jobs
 			}
 			lastRunMinute = nowMinuteOfDay
 		}
 	}
 }
 
+// runScheduledJob runs the command of a scheduled job on hosts of ou.
+func runScheduledJob(job database.Job, ou database.Ou, hosts []database.Host, verbose bool) {
+	optionset := prepareOptionset(database.GetOptionset(*ou.OptionsetID))
+	var hostsStringArr []string
+	for _, h := range hosts {
+		hostsStringArr = append(hostsStringArr, h.Hostname)
+	}
+	if verbose {
+		log.Printf("Scheduled command: %s, delay: %f, hosts: %s", *job.AmtcCmd, *job.AmtcDelay, hostsStringArr)
+	}
+	if *job.AmtcCmd == "U" {
+		database.InsertNotification(database.NotificationTypePowerOn, fmt.Sprintf("Scheduled power-up %s", ou.Name))
+	} else if *job.AmtcCmd == "D" {
+		database.InsertNotification(database.NotificationTypePowerOff, fmt.Sprintf("Scheduled power-down %s", ou.Name))
+	}
+
+	cmd, err := jobCommand(*job.AmtcCmd, job.AmtcBootdevice, &optionset)
+	if err != nil {
+		log.Printf("Scheduled job %d: %s", job.ID, err)
+		database.InsertNotification(database.NotificationTypeWarning, fmt.Sprintf("Scheduled job %d: %s", job.ID, err))
+		return
+	}
+	go runCommand(cmd, hostsStringArr, optionset, *job.AmtcDelay)
+}
+
+// runCommand runs cmd on hosts one by one, as scheduled and GUI submitted
+// jobs do, and notifies about hosts that failed. Each host gets its delay
+// and commandTimeout; hosts not reached by then are cancelled.
+func runCommand(cmd string, hostnames []string, optionset amt.Optionset, delay float64) {
+	perHost := time.Duration(delay*float64(time.Second)) + commandTimeout(optionset)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(len(hostnames))*perHost)
+	defer cancel()
+	results := amt.SequentialCommandContext(ctx, cmd, hostnames, optionset, delay)
+
+	failed := make(map[string][]string)
+	for _, result := range results {
+		if result.Err != nil {
+			kind := errorKind(result.Err)
+			failed[kind] = append(failed[kind], result.Hostname)
+		}
+	}
+	if len(results) < len(hostnames) {
+		failed["cancelled"] = hostnames[len(results):]
+	}
+	var kinds []string
+	for kind := range failed {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		database.InsertNotification(database.NotificationTypeWarning,
+			fmt.Sprintf("%s %s: %s", cmd, kind, strings.Join(failed[kind], ", ")))
+	}
+}
+
+// commandTimeout limits running a command on a single host, which may take
+// several AMT requests, e.g. to configure a one-time boot first.
+func commandTimeout(optionset amt.Optionset) time.Duration {
+	timeout := time.Duration(optionset.OptTimeout) * time.Second
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return 4 * timeout
+}
+
+// errorKind tells why an AMT command failed, for notifications.
+func errorKind(err error) string {
+	var (
+		unauthorized *amt.ErrUnauthorized
+		tlsVerify    *amt.ErrTLSVerify
+		timeout      *amt.ErrTimeout
+		unsupported  *amt.ErrUnsupported
+	)
+	switch {
+	case errors.As(err, &unauthorized):
+		return "credentials rejected"
+	case errors.As(err, &tlsVerify):
+		return "TLS verification failed"
+	case errors.As(err, &timeout):
+		return "timed out"
+	case errors.As(err, &unsupported):
+		return "unsupported"
+	}
+	return "failed"
+}
+
 // CreateJob accepts a web-GUI submitted job, scheduled or interactive
 func CreateJob(body io.ReadCloser) string {
 	var uncleanJob newJob
 	decoder := json.NewDecoder(body)
 	err := decoder.Decode(&uncleanJob)
+	if err == nil {
+		err = checkBootdevice(uncleanJob.SingleJob.AmtcBootdevice)
+	}
 	if err == nil {
 		j := uncleanJob.SingleJob
 		ouid, _ := strconv.Atoi(j.OuID)
 		switch j.JobType {
 		case 1: // interactive job
 			ou := database.GetOu(ouid)
-			optionset := database.GetOptionset(*ou.OptionsetID)
-			optionset.Username = "admin" // to-do: jobs assume default AMT username admin
-			optionset.Password = getPasswordFromFile(optionset.OptPassfile)
-			if optionset.SwUseTLS == 1 && optionset.SwSkipcertchk != 1 && optionset.OptCacertfile != "" {
-				optionset.CaCertData = amt.LoadCaCertFile(optionset.OptCacertfile)
-			}
+			optionset := prepareOptionset(database.GetOptionset(*ou.OptionsetID))
 			// ember submits hostIDs as string. convert...
 			hostnames := database.GetHostNamesByID(j.AmtcHosts)
-			message := fmt.Sprintf("%s %d hosts in %s", amt.ShortCommandMap[j.AmtcCmd], len(hostnames), ou.Name)
+			cmd, err := jobCommand(j.AmtcCmd, j.AmtcBootdevice, &optionset)
+			if err != nil {
+				return `{ "error" : "` + err.Error() + `"}`
+			}
+			message := fmt.Sprintf("%s %d hosts in %s", cmd, len(hostnames), ou.Name)
 			database.InsertNotification(database.NotificationTypeUser, message)
-			go amt.SequentialCommand(amt.ShortCommandMap[j.AmtcCmd], hostnames, optionset, j.AmtcDelay)
+			go runCommand(cmd, hostnames, optionset, j.AmtcDelay)
 			return "{}"
 		default: // scheduled job
 			var sjob database.Job
@@ -118,6 +202,9 @@ func CreateJob(body io.ReadCloser) string {
 			amtOu := &defaultOu
 			amtCmd := &defaultAmtCmd
 			amtDelay := &defaultAmtDelay
+			if j.JobType == database.JobTypeTimesync {
+				defaultAmtCmd = "T"
+			}
 			if j.AmtcCmd != "" {
 				amtCmd = &j.AmtcCmd
 			}
@@ -128,14 +215,21 @@ func CreateJob(body io.ReadCloser) string {
 				amtOu = &ouid
 			}
 			// ^^^
-			sjob.JobType = 2
+			sjob.JobType = scheduledJobType(j.JobType)
 			sjob.AmtcCmd = amtCmd
 			sjob.AmtcDelay = amtDelay
+			sjob.AmtcBootdevice = j.AmtcBootdevice
 			sjob.OuID = amtOu
 			sjob.StartTime = j.StartTime
+			sjob.RepeatInterval = j.RepeatInterval
 			sjob.RepeatDays = j.RepeatDays
 			sjob.Description = j.Description
-			return database.InsertJob(sjob)
+			sjob.AlarmClock = alarmClockFlag(j.AlarmClock, *sjob.AmtcCmd, 0)
+			response := database.InsertJob(sjob)
+			if sjob.AlarmClock == 1 {
+				go PushAlarms(false)
+			}
+			return response
 		}
 	}
 	return `{ "error" : "` + err.Error() + `"}`
@@ -146,21 +240,82 @@ func UpdateJob(id int, body io.ReadCloser) string {
 	var uncleanJob newJob
 	decoder := json.NewDecoder(body)
 	err := decoder.Decode(&uncleanJob)
+	if err == nil {
+		err = checkBootdevice(uncleanJob.SingleJob.AmtcBootdevice)
+	}
 	if err == nil {
 		j := uncleanJob.SingleJob
 		ouid, _ := strconv.Atoi(j.OuID)
 		var sjob database.Job
 		sjob.ID = id
-		sjob.JobType = 2
+		sjob.JobType = scheduledJobType(j.JobType)
 		sjob.AmtcCmd = &j.AmtcCmd
 		sjob.AmtcDelay = &j.AmtcDelay
+		sjob.AmtcBootdevice = j.AmtcBootdevice
 		sjob.OuID = &ouid
 		sjob.StartTime = j.StartTime
+		sjob.RepeatInterval = j.RepeatInterval
 		sjob.RepeatDays = j.RepeatDays
 		sjob.Description = j.Description
-		return database.UpdateJob(sjob)
+		previous := database.GetJob(id)
+		sjob.AlarmClock = alarmClockFlag(j.AlarmClock, j.AmtcCmd, previous.AlarmClock)
+		response := database.UpdateJob(sjob)
+		if sjob.AlarmClock == 1 || previous.AlarmClock == 1 {
+			go PushAlarms(false)
+		}
+		return response
 	}
-	return "{}"
+	return `{ "error" : "` + err.Error() + `"}`
+}
+
+// alarmClockFlag returns the stored alarm_clock value of a submitted job.
+// Only power-up jobs may run by AMT alarm clock; if not submitted, the
+// previous value is kept.
+func alarmClockFlag(submitted *bool, amtcCmd string, previous int) int {
+	if amt.ShortCommandMap[amtcCmd] != amt.CmdUp {
+		return 0
+	}
+	if submitted == nil {
+		return previous
+	}
+	if *submitted {
+		return 1
+	}
+	return 0
+}
+
+// scheduledJobType returns the stored type of a submitted non-interactive job.
+func scheduledJobType(jobType int) int {
+	if jobType == database.JobTypeTimesync {
+		return jobType
+	}
+	return 2
+}
+
+// jobCommand returns the amt command to run for a job. Power up, reset and
+// power cycle jobs having a boot device configure a one-time boot via optionset first.
+func jobCommand(amtcCmd string, bootdevice *string, optionset *amt.Optionset) (string, error) {
+	cmd := amt.ShortCommandMap[amtcCmd]
+	if bootdevice == nil || *bootdevice == "" || (cmd != amt.CmdUp && cmd != amt.CmdReset && cmd != amt.CmdCycle) {
+		return cmd, nil
+	}
+	if err := checkBootdevice(bootdevice); err != nil {
+		return "", err
+	}
+	optionset.Boot = amt.BootOptions{Device: amt.BootDeviceShortMap[*bootdevice], Then: cmd}
+	return amt.CmdBoot, nil
+}
+
+// checkBootdevice returns an error unless a job's boot device is empty or
+// a known letter of amt.BootDeviceShortMap.
+func checkBootdevice(bootdevice *string) error {
+	if bootdevice == nil || *bootdevice == "" {
+		return nil
+	}
+	if _, ok := amt.BootDeviceShortMap[*bootdevice]; !ok {
+		return fmt.Errorf("unsupported boot device '%s'", *bootdevice)
+	}
+	return nil
 }
 
 // MonitoringRunloop periodically scans clients' powerstate via AMT.
@@ -174,6 +329,7 @@ func MonitoringRunloop(verbose bool) {
 		if verbose {
 			log.Println("Host monitoring triggering scans...")
 		}
+		ctx, cancel := context.WithTimeout(context.Background(), monitoringPassTimeout)
 		optionsets := database.GetOptionsets()
 		hosts := database.GetHosts()
 		ous := database.GetOus()
@@ -185,11 +341,7 @@ func MonitoringRunloop(verbose bool) {
 			for _, ouX := range ous {
 				// FIXME: Web-GUI says "Log(ging)" in OU, but it's monitoring+logging!
 				if ouX.Logging == 1 && *ouX.OptionsetID == optionsetX.ID {
-					optionsetX.Password = getPasswordFromFile(optionsetX.OptPassfile)
-					optionsetX.Username = "admin"
-					if optionsetX.SwUseTLS == 1 && optionsetX.SwSkipcertchk != 1 && optionsetX.OptCacertfile != "" {
-						optionsetX.CaCertData = amt.LoadCaCertFile(optionsetX.OptCacertfile)
-					}
+					optionsetX = prepareOptionset(optionsetX)
 					//log.Printf("  Scan ou %s", ouX.Name)
 					for _, hostX := range hosts {
 						if hostX.Enabled == 1 && hostX.OuID == ouX.ID && *ouX.OptionsetID == optionsetX.ID {
@@ -203,11 +355,18 @@ func MonitoringRunloop(verbose bool) {
 							go func() {
 								defer func() { <-sem }()
 								//log.Printf("Go command for: %s", client.Hostname)
-								result := amt.Command(client, cmd, optionsetX)
+								if ctx.Err() != nil {
+									return // pass took too long, scan again next pass
+								}
+								result := amt.CommandContext(ctx, client, cmd, optionsetX)
 								if verbose {
-									log.Printf("%s %-15s OS:%-7d AMT:%02d HTTP:%03d %s\n", cmd, result.Hostname,
-										result.OpenPort, result.StateAMT, result.StateHTTP, result.Usermessage)
+									log.Printf("%s %-15s OS:%-7d AMT:%02d CIM:%02d HTTP:%03d %s\n", cmd, result.Hostname,
+										result.OpenPort, result.StateAMT, result.StatePower, result.StateHTTP, result.Usermessage)
+								}
+								if result.Err != nil && ctx.Err() != nil {
+									return // cancelled by pass deadline, host may well be up
 								}
+								warnMonitoringError(result)
 								updateLastStateMap(result)
 							}()
 						}
@@ -218,18 +377,46 @@ func MonitoringRunloop(verbose bool) {
 		for i := 0; i < cap(sem); i++ {
 			sem <- true
 		}
+		cancel()
 		if verbose {
 			log.Printf("Host monitoring scans done -- sleeping")
 		}
 	}
 }
 
+// warnMonitoringError notifies once if AMT of a monitored host rejects the
+// credentials or fails TLS verification -- unlike timeouts, these won't
+// pass by powering the host on.
+func warnMonitoringError(result amt.Laststate) {
+	var (
+		unauthorized *amt.ErrUnauthorized
+		tlsVerify    *amt.ErrTLSVerify
+		kind         string
+	)
+	if errors.As(result.Err, &unauthorized) || errors.As(result.Err, &tlsVerify) {
+		kind = errorKind(result.Err)
+	}
+	mutex.Lock()
+	warned := monitoringWarnings[result.HostID]
+	if kind == "" {
+		delete(monitoringWarnings, result.HostID)
+	} else {
+		monitoringWarnings[result.HostID] = kind
+	}
+	mutex.Unlock()
+	if kind != "" && kind != warned {
+		database.InsertNotification(database.NotificationTypeWarning,
+			fmt.Sprintf("Monitoring %s: %s", result.Hostname, kind))
+	}
+}
+
 func updateLastStateMap(stateNow amt.Laststate) {
 	// diff lastStateMap with newstate
 	mutex.Lock()
 	if last, ok := lastStateMap[stateNow.HostID]; ok {
 		if last.OpenPort == stateNow.OpenPort &&
 			last.StateAMT == stateNow.StateAMT &&
+			last.StatePower == stateNow.StatePower &&
 			last.StateHTTP == stateNow.StateHTTP &&
 			last.Usermessage == stateNow.Usermessage {
 			mutex.Unlock()
@@ -241,17 +428,53 @@ func updateLastStateMap(stateNow amt.Laststate) {
 	lastStateMap[stateNow.HostID] = stateNow
 	mutex.Unlock()
 
-	database.InsertStatelog(stateNow.HostID, stateNow.StateHTTP, stateNow.StateAMT, stateNow.OpenPort)
+	database.InsertStatelog(stateNow.HostID, stateNow.StateHTTP, stateNow.StateAMT, stateNow.OpenPort, stateNow.StatePower)
+}
+
+// forEachHost runs fn for all enabled hosts of OUs having an optionset,
+// using at most concurrency go routines. It returns when all are done.
+func forEachHost(concurrency int, fn func(host database.Host, optionset amt.Optionset)) {
+	forEachOuHost(concurrency, func(ou database.Ou) bool { return true }, fn)
+}
+
+// forEachOuHost is forEachHost, limited to OUs selected by include.
+func forEachOuHost(concurrency int, include func(ou database.Ou) bool, fn func(host database.Host, optionset amt.Optionset)) {
+	sem := make(chan bool, concurrency)
+	for _, ou := range database.GetOus() {
+		if ou.OptionsetID == nil || !include(ou) {
+			continue
+		}
+		optionset := prepareOptionset(database.GetOptionset(*ou.OptionsetID))
+		for _, host := range database.GetHostsByOu(ou.ID) {
+			if host.Enabled != 1 {
+				continue
+			}
+			sem <- true
+			go func(host database.Host, optionset amt.Optionset) {
+				defer func() { <-sem }()
+				fn(host, optionset)
+			}(host, optionset)
+		}
+	}
+	for i := 0; i < cap(sem); i++ {
+		sem <- true
+	}
 }
 
-func getPasswordFromFile(f string) (password string) {
-	fileContents, err := ioutil.ReadFile(f)
+// prepareOptionset adds credentials and CA certificate to a DB optionset.
+func prepareOptionset(optionset amt.Optionset) amt.Optionset {
+	if optionset.Username == "" {
+		optionset.Username = "admin"
+	}
+	password, err := amt.ReadPasswordFile(optionset.OptPassfile)
 	if err != nil {
-		log.Printf("Error opening password file %s: %s", f, err)
-		return
+		log.Printf("Error opening password file %s: %s", optionset.OptPassfile, err)
+	}
+	optionset.Password = password
+	if optionset.SwUseTLS == 1 && optionset.SwSkipcertchk != 1 && optionset.OptCacertfile != "" {
+		optionset.CaCertData = amt.LoadCaCertFile(optionset.OptCacertfile)
 	}
-	password = strings.TrimSpace(string(fileContents))
-	return
+	return optionset
 }
 
 // GetLaststatesJSON is consumed by webserver to report current client state.
@@ -0,0 +1,73 @@
+package scheduler
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/schnoddelbotz/amtgo/amt"
+	"github.com/schnoddelbotz/amtgo/database"
+)
+
+// default repeat interval of timesync jobs, in minutes
+const defaultTimesyncInterval = 24 * 60
+
+// clock drift logged even if not verbose
+const significantDrift = 5 * time.Second
+
+// TimesyncRunloop runs time synchronization jobs when due.
+func TimesyncRunloop(verbose bool) {
+	for {
+		time.Sleep(30 * time.Second) // sleep first -- db may not be open yet...
+		now := int(time.Now().Unix())
+		for _, job := range database.GetTimesyncJobs() {
+			interval := defaultTimesyncInterval
+			if job.RepeatInterval != nil && *job.RepeatInterval > 0 {
+				interval = *job.RepeatInterval
+			}
+			if job.LastStarted != nil && now < *job.LastStarted+interval*60 {
+				continue
+			}
+			SyncClocks(verbose)
+			if err := database.UpdateJobRun(job.ID, now, int(time.Now().Unix())); err != nil {
+				log.Printf("Error updating timesync job %d: %s", job.ID, err)
+			}
+		}
+	}
+}
+
+// SyncClocks synchronizes AMT clocks of all enabled hosts in monitored OUs.
+func SyncClocks(verbose bool) {
+	var (
+		lock     sync.Mutex
+		synced   int
+		failed   int
+		maxDrift time.Duration
+	)
+	monitored := func(ou database.Ou) bool { return ou.Logging == 1 }
+	forEachOuHost(20, monitored, func(host database.Host, optionset amt.Optionset) {
+		drift, err := amt.NewClient(host.Hostname, optionset).SyncClock()
+		lock.Lock()
+		defer lock.Unlock()
+		if err != nil {
+			failed++
+			if verbose {
+				log.Printf("Time sync of %s failed: %s", host.Hostname, err)
+			}
+			return
+		}
+		synced++
+		if drift < 0 {
+			drift = -drift
+		}
+		if drift > maxDrift {
+			maxDrift = drift
+		}
+		if verbose || drift >= significantDrift {
+			log.Printf("Time sync of %s: AMT clock was off by %s", host.Hostname, drift)
+		}
+	})
+	message := fmt.Sprintf("Time sync: %d hosts synchronized, %d failed, max. drift %s", synced, failed, maxDrift)
+	database.InsertNotification(database.NotificationTypeComment, message)
+}
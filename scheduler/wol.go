@@ -0,0 +1,28 @@
+package scheduler
+
+import (
+	"fmt"
+
+	"github.com/schnoddelbotz/amtgo/database"
+)
+
+// CliWakeOnLANHost sets Wake-on-LAN settings of a host: its MAC address
+// (empty keeps the one collected from AMT), a broadcast address overriding
+// the host's subnet-directed broadcast and whether it is woken by WoL only.
+func CliWakeOnLANHost(args []string, macAddress string, broadcast string, wolOnly bool) {
+	if len(args) != 1 {
+		fmt.Println("Error: Expected hostname as argument")
+		return
+	}
+	// this happens from terminal, so DB is not open yet...
+	database.OpenDB()
+	defer database.CloseDB()
+	if err := database.UpdateHostWakeOnLAN(args[0], macAddress, broadcast, wolOnly); err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+	host, _ := database.GetHostByName(args[0])
+	if host.MacAddress == "" {
+		fmt.Printf("Warning: %s has no MAC address yet -- set it or collect inventory\n", host.Hostname)
+	}
+}
@@ -6,11 +6,17 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
+	"fmt"
+	"io/ioutil"
 	"log"
 	"math/big"
 	"net"
 	"os"
+	"path/filepath"
 	"time"
+
+	"github.com/schnoddelbotz/amtgo/database"
+	"github.com/schnoddelbotz/amtgo/scheduler"
 )
 
 func checkCertFilesAreReadable(path string) bool {
@@ -34,31 +40,193 @@ func checkCertFilesAreReadable(path string) bool {
 	return false
 }
 
-func createSelfSignedCert(path string) {
+// files of amtgo's internal CA, in TLSCertDir
+const (
+	caCertFile = "ca.pem"
+	caKeyFile  = "ca-key.pem"
+)
+
+const (
+	caValidity       = 10 * 365 * 24 * time.Hour
+	hostCertValidity = 3 * 365 * 24 * time.Hour
+)
+
+// internalCA issues the web server's and AMT hosts' TLS certificates.
+type internalCA struct {
+	certFile string
+	cert     *x509.Certificate
+	key      *rsa.PrivateKey
+}
+
+// loadCA loads the CA from path, creating a new one if none exists.
+func loadCA(path string) (*internalCA, error) {
+	certFile, err := filepath.Abs(filepath.Join(path, caCertFile))
+	if err != nil {
+		return nil, err
+	}
+	keyFile := filepath.Join(path, caKeyFile)
+	if _, err = os.Stat(certFile); os.IsNotExist(err) {
+		log.Printf("No CA found in %s, creating new CA...", path)
+		if err = createCA(certFile, keyFile); err != nil {
+			return nil, err
+		}
+	}
+
+	ca := &internalCA{certFile: certFile}
+	if ca.cert, err = readCertificate(certFile); err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in %s", keyFile)
+	}
+	if ca.key, err = x509.ParsePKCS1PrivateKey(block.Bytes); err != nil {
+		return nil, err
+	}
+	return ca, nil
+}
+
+func createCA(certFile string, keyFile string) error {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return err
+	}
+	template := &x509.Certificate{
+		SerialNumber: newSerialNumber(),
+		Subject: pkix.Name{
+			OrganizationalUnit: []string{"amtgo"},
+			CommonName:         "amtgo CA",
+		},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(caValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return err
+	}
+	if err = writePEM(keyFile, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(key), 0600); err != nil {
+		return err
+	}
+	return writePEM(certFile, "CERTIFICATE", der, 0644)
+}
+
+// Issue returns a TLS server certificate (DER) for hostname, using the
+// public key of a certificate request.
+func (ca *internalCA) Issue(hostname string, csr *x509.CertificateRequest) ([]byte, error) {
+	if err := csr.CheckSignature(); err != nil {
+		return nil, err
+	}
+	return ca.issue([]string{hostname}, csr.PublicKey)
+}
+
+func (ca *internalCA) issue(hostnames []string, publicKey interface{}) ([]byte, error) {
+	template := &x509.Certificate{
+		SerialNumber: newSerialNumber(),
+		Subject: pkix.Name{
+			OrganizationalUnit: []string{"amtgo"},
+			CommonName:         hostnames[0],
+		},
+		NotBefore:   time.Now().Add(-time.Hour),
+		NotAfter:    time.Now().Add(hostCertValidity),
+		KeyUsage:    x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	for _, hostname := range hostnames {
+		if ip := net.ParseIP(hostname); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, hostname)
+		}
+	}
+	return x509.CreateCertificate(rand.Reader, template, ca.cert, publicKey, ca.key)
+}
+
+// Certificate returns the CA certificate (DER).
+func (ca *internalCA) Certificate() []byte {
+	return ca.cert.Raw
+}
+
+// CertificateFile returns the absolute path of the CA certificate (PEM).
+func (ca *internalCA) CertificateFile() string {
+	return ca.certFile
+}
+
+// createServerCert creates the web server's cert.pem and key.pem, issued by the internal CA.
+func createServerCert(path string) error {
+	ca, err := loadCA(path)
+	if err != nil {
+		return err
+	}
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return err
+	}
+	hostnames := []string{"localhost", "127.0.0.1"}
+	if hostname, err := os.Hostname(); err == nil {
+		hostnames = append([]string{hostname}, hostnames...)
+	}
+	der, err := ca.issue(hostnames, &key.PublicKey)
+	if err != nil {
+		return err
+	}
+	if err = writePEM(path+"/key.pem", "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(key), 0600); err != nil {
+		return err
+	}
+	return writePEM(path+"/cert.pem", "CERTIFICATE", der, 0644)
+}
+
+func newSerialNumber() *big.Int {
 	max := new(big.Int).Lsh(big.NewInt(1), 128)
 	serialNumber, _ := rand.Int(rand.Reader, max)
-	subject := pkix.Name{
-		Organization:       []string{"SnakeOil Ltd."},
-		OrganizationalUnit: []string{"amtgo"},
-		CommonName:         "amtgo self-signed cert",
-	}
-	template := x509.Certificate{
-		SerialNumber: serialNumber,
-		Subject:      subject,
-		NotBefore:    time.Now(),
-		NotAfter:     time.Now().Add(365 * 24 * time.Hour),
-		KeyUsage:     x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
-		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
-		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
-	}
-
-	pk, _ := rsa.GenerateKey(rand.Reader, 2048)
-	derBytes, _ := x509.CreateCertificate(rand.Reader, &template, &template, &pk.PublicKey, pk)
-	certOut, _ := os.Create(path + "/cert.pem")
-	pem.Encode(certOut, &pem.Block{Type: "CERTIFICATE", Bytes: derBytes})
-	certOut.Close()
-
-	keyOut, _ := os.Create(path + "/key.pem")
-	pem.Encode(keyOut, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(pk)})
-	keyOut.Close()
+	return serialNumber
+}
+
+func readCertificate(filename string) (*x509.Certificate, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in %s", filename)
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func writePEM(filename string, blockType string, der []byte, mode os.FileMode) error {
+	out, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	if err = pem.Encode(out, &pem.Block{Type: blockType, Bytes: der}); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// CliProvisionTLS runs scheduler.ProvisionTLS using the internal CA.
+func CliProvisionTLS(args []string, verbose bool) {
+	if len(args) != 1 {
+		fmt.Println("Error: Expected OU name as argument")
+		return
+	}
+	ca, err := loadCA(TLSCertDir)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+	// this happens from terminal, so DB is not open yet...
+	database.OpenDB()
+	defer database.CloseDB()
+	if err = scheduler.ProvisionTLS(args[0], ca, verbose); err != nil {
+		fmt.Printf("Error: %s\n", err)
+	}
 }
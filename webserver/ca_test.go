@@ -0,0 +1,46 @@
+package webserver
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestInternalCA(t *testing.T) {
+	tempdir, err := ioutil.TempDir("", "amtgo-ca")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempdir)
+
+	ca, err := loadCA(tempdir)
+	if err != nil {
+		t.Fatalf("loadCA failed: %s", err)
+	}
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	der, _ := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{}, key)
+	csr, _ := x509.ParseCertificateRequest(der)
+	issued, err := ca.Issue("host1.example.com", csr)
+	if err != nil {
+		t.Fatalf("Issue failed: %s", err)
+	}
+
+	// reloading the CA must not replace it
+	reloaded, err := loadCA(tempdir)
+	if err != nil {
+		t.Fatalf("loadCA failed: %s", err)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(reloaded.cert)
+	certificate, _ := x509.ParseCertificate(issued)
+	if _, err = certificate.Verify(x509.VerifyOptions{DNSName: "host1.example.com", Roots: roots}); err != nil {
+		t.Errorf("Issued certificate doesn't verify against CA: %s", err)
+	}
+
+	if err = createServerCert(tempdir); err != nil || !checkCertFilesAreReadable(tempdir) {
+		t.Errorf("createServerCert failed: %v", err)
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"runtime"
@@ -19,6 +21,7 @@ import (
 	"github.com/gorilla/securecookie"
 	"github.com/gorilla/sessions"
 
+	"github.com/schnoddelbotz/amtgo/amt"
 	"github.com/schnoddelbotz/amtgo/database"
 	"github.com/schnoddelbotz/amtgo/scheduler"
 )
@@ -69,6 +72,11 @@ var (
 		"notifications":  {nil, database.GetNotificationsJSON, database.GetNotificationJSON, nil, nil},
 		"users":          {nil, database.GetUsersJSON, database.GetUserJSON, nil, database.DeleteUser},
 		"hosts":          {database.InsertHost, database.GetHostsJSON, database.GetHostJSON, nil, database.DeleteHost},
+		"inventories":    {nil, database.GetInventoriesJSON, database.GetInventoryJSON, nil, nil},
+		"eventlogs":      {nil, database.GetEventsJSON, database.GetEventlogJSON, nil, nil},
+		"auditlogs":      {nil, database.GetAuditRecordsJSON, database.GetAuditlogJSON, nil, nil},
+		"alerts":         {nil, database.GetAlertsJSON, database.GetHostAlertsJSON, nil, nil},
+		"firmwareaudits": {nil, database.GetFirmwareAuditsJSON, database.GetFirmwareAuditJSON, nil, nil},
 		"laststates":     {nil, scheduler.GetLaststatesJSON, database.GetLaststateJSON, nil, nil},
 		"optionsets":     {database.InsertOptionset, database.GetOptionsetsJSON, database.GetOptionsetJSON, database.UpdateOptionset, database.DeleteOptionset},
 		"jobs":           {scheduler.CreateJob, database.GetJobsJSON, database.GetJobJSON, scheduler.UpdateJob, database.DeleteJob},
@@ -127,11 +135,15 @@ func Run(verbose bool) {
 	r.Handle("/rest-api.php/{.*}", restAPIHandler)
 	r.Handle("/rest-api.php/{.*}/{.*}", restAPIHandler)
 	r.Handle("/rest-api.php/statelogs/{.*}/{.*}", statelogAPIHandler)
+	r.Handle("/rest-api.php/auditlogs/{.*}/csv", auditlogCSVHandler)
+	r.Handle("/amt-events/{hostname}", alertHandler).Methods(http.MethodPost)
 
 	var err error
 	if HttpdUseTLS {
 		if !checkCertFilesAreReadable(TLSCertDir) {
-			createSelfSignedCert(TLSCertDir)
+			if err = createServerCert(TLSCertDir); err != nil {
+				log.Fatalf("Cannot create TLS certificate: %s", err)
+			}
 		}
 		err = http.ListenAndServeTLS(ListenAddr, TLSCertDir+"/cert.pem", TLSCertDir+"/key.pem", handlers.LoggingHandler(os.Stdout, r))
 	} else {
@@ -318,3 +330,70 @@ var statelogAPIHandler = http.HandlerFunc(func(w http.ResponseWriter, request *h
 	w.Header().Set("Content-Type", "application/json")
 	w.Write(database.GetStatelogsJSON(ouID, unixtime))
 })
+
+var auditlogCSVHandler = http.HandlerFunc(func(w http.ResponseWriter, request *http.Request) {
+	session, err := store.Get(request, "amtgo-session")
+	if !DisableSessions && (err != nil || session.Values["username"] == nil) {
+		http.Error(w, "unauthenticated", http.StatusUnauthorized)
+		return
+	}
+	pathComponents := strings.Split(request.URL.Path[1:], "/")
+	hostID, err := strconv.Atoi(pathComponents[2])
+	if err != nil {
+		http.NotFound(w, request)
+		return
+	}
+	auditlog := database.GetAuditlog(hostID)
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", "attachment; filename=\"auditlog-"+auditlog.Hostname+".csv\"")
+	amt.WriteAuditLogCSV(w, []amt.AuditLog{auditlog})
+})
+
+// alertHandler receives alerts pushed by AMT hosts subscribed using
+// 'amtgo events subscribe'. AMT cannot authenticate itself, so alerts are
+// only accepted from known hosts at their known addresses.
+var alertHandler = http.HandlerFunc(func(w http.ResponseWriter, request *http.Request) {
+	hostname := mux.Vars(request)["hostname"]
+	host, err := database.GetHostByName(hostname)
+	if err != nil || !isHostAddress(host, request.RemoteAddr) {
+		http.Error(w, "unknown host", http.StatusForbidden)
+		return
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(request.Body, 1<<20))
+	request.Body.Close()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	alert, ack, err := amt.ParseAlert(data)
+	if err != nil {
+		log.Printf("%s: invalid alert: %s", hostname, err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err = database.InsertAlert(host, alert); err != nil {
+		log.Printf("DB ERROR @ insert alert: %s", err)
+		http.Error(w, "cannot store alert", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/soap+xml;charset=UTF-8")
+	w.Write(ack)
+})
+
+// isHostAddress returns true if remoteAddr belongs to host.
+func isHostAddress(host database.Host, remoteAddr string) bool {
+	ip, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return false
+	}
+	if host.IPAddress == ip {
+		return true
+	}
+	addresses, _ := net.LookupHost(host.Hostname)
+	for _, address := range addresses {
+		if address == ip {
+			return true
+		}
+	}
+	return false
+}